@@ -0,0 +1 @@
+alter table `azure_translation` add column `expires_at` datetime null;
@@ -0,0 +1,3 @@
+alter table `azure_translation` add column `created_at` datetime not null default current_timestamp;
+alter table `azure_translation` add column `refreshed_at` datetime not null default current_timestamp;
+create index `idx_azure_translation_refreshed_at` on `azure_translation`(`refreshed_at`);
@@ -0,0 +1,22 @@
+create table `azure_translation_candidate` (
+ `text` varchar(30) character set ascii not null
+,`lang2` varchar(2) character set ascii not null
+,`rank` int not null
+,`pos` int not null
+,`target` varchar(100) not null
+,`confidence` double not null
+,primary key(`text`, `lang2`, `rank`)
+);
+create index `idx_azure_translation_candidate_lang2_pos` on `azure_translation_candidate`(`lang2`, `pos`);
+
+insert into `azure_translation_candidate` (`text`, `lang2`, `rank`, `pos`, `target`, `confidence`)
+select t.`text`, t.`lang2`, c.`rank` - 1, c.`pos`, c.`target`, c.`confidence`
+from `azure_translation` t
+,json_table(t.`result`, '$[*]' columns(
+  `rank` for ordinality
+ ,`pos` int path '$.Pos'
+ ,`target` varchar(100) path '$.Target'
+ ,`confidence` double path '$.Confidence'
+)) c;
+
+alter table `azure_translation` drop column `result`;
@@ -0,0 +1,3 @@
+alter table `azure_translation_candidate` add column `normalized_source` varchar(30) not null default '';
+alter table `azure_translation_candidate` add column `prefix_word` varchar(30) not null default '';
+alter table `azure_translation_candidate` add column `back_translations` json not null default (json_array());
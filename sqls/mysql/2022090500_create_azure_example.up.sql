@@ -0,0 +1,21 @@
+create table `azure_example` (
+ `text` varchar(30) character set ascii not null
+,`lang2` varchar(2) character set ascii not null
+,`translated` varchar(100) not null
+,`created_at` datetime not null default current_timestamp
+,primary key(`text`, `lang2`, `translated`)
+);
+
+create table `azure_example_sentence` (
+ `text` varchar(30) character set ascii not null
+,`lang2` varchar(2) character set ascii not null
+,`translated` varchar(100) not null
+,`rank` int not null
+,`source_prefix` text not null
+,`source_term` varchar(100) not null
+,`source_suffix` text not null
+,`target_prefix` text not null
+,`target_term` varchar(100) not null
+,`target_suffix` text not null
+,primary key(`text`, `lang2`, `translated`, `rank`)
+);
@@ -0,0 +1,11 @@
+create table `azure_text_translation` (
+ `text_hash` char(64) character set ascii not null
+,`from_lang` varchar(2) character set ascii not null
+,`to_lang` varchar(2) character set ascii not null
+,`text` text not null
+,`translated` text not null
+,`detected_lang` varchar(2) character set ascii not null
+,`detected_lang_score` double not null
+,`created_at` datetime not null default current_timestamp
+,primary key(`text_hash`, `from_lang`, `to_lang`)
+);
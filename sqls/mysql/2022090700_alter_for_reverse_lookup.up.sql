@@ -0,0 +1,7 @@
+alter table `custom_translation` modify `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null;
+alter table `azure_translation` modify `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null;
+alter table `azure_translation_candidate` modify `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null;
+alter table `azure_example` modify `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null;
+alter table `azure_example_sentence` modify `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null;
+create index `idx_custom_translation_lang2_translated` on `custom_translation`(`lang2`, `translated`);
+create index `idx_azure_translation_candidate_lang2_target` on `azure_translation_candidate`(`lang2`, `target`);
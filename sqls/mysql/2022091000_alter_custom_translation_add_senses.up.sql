@@ -0,0 +1,2 @@
+alter table `custom_translation` add column `senses` json not null default (json_array());
+alter table `custom_translation_history` add column `new_senses` json not null default (json_array());
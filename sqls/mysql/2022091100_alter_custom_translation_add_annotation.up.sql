@@ -0,0 +1,12 @@
+alter table `custom_translation` add column `note` varchar(500) not null default '';
+alter table `custom_translation` add column `labels` json not null default (json_array());
+alter table `custom_translation` add column `tags` json not null default (json_array());
+alter table `custom_translation` add column `level` varchar(2) character set ascii not null default '';
+create index `idx_custom_translation_lang2_level` on `custom_translation`(`lang2`, `level`);
+create table `custom_translation_tag` (
+ `text` varchar(30) character set utf8mb4 collate utf8mb4_0900_as_ci not null
+,`pos` int not null
+,`lang2` varchar(2) character set ascii not null
+,`tag` varchar(30) not null
+,primary key(`lang2`, `tag`, `text`, `pos`)
+);
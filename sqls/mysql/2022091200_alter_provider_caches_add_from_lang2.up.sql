@@ -0,0 +1,4 @@
+alter table `azure_translation` add column `from_lang2` varchar(2) character set ascii not null default 'en' after `text`, drop primary key, add primary key(`text`, `from_lang2`, `lang2`);
+alter table `azure_translation_candidate` add column `from_lang2` varchar(2) character set ascii not null default 'en' after `text`, drop primary key, add primary key(`text`, `from_lang2`, `lang2`, `rank`);
+alter table `azure_example` add column `from_lang2` varchar(2) character set ascii not null default 'en' after `text`, drop primary key, add primary key(`text`, `from_lang2`, `lang2`, `translated`);
+alter table `azure_example_sentence` add column `from_lang2` varchar(2) character set ascii not null default 'en' after `text`, drop primary key, add primary key(`text`, `from_lang2`, `lang2`, `translated`, `rank`);
@@ -0,0 +1,3 @@
+alter table `azure_translation` add column `created_at` datetime not null default '1970-01-01 00:00:00';
+alter table `azure_translation` add column `refreshed_at` datetime not null default '1970-01-01 00:00:00';
+create index `idx_azure_translation_refreshed_at` on `azure_translation`(`refreshed_at`);
@@ -0,0 +1,17 @@
+create table `azure_translation_candidate` (
+ `text` varchar(30) not null
+,`lang2` varchar(2) not null
+,`rank` int not null
+,`pos` int not null
+,`target` varchar(100) not null
+,`confidence` double not null
+,primary key(`text`, `lang2`, `rank`)
+);
+create index `idx_azure_translation_candidate_lang2_pos` on `azure_translation_candidate`(`lang2`, `pos`);
+
+insert into `azure_translation_candidate` (`text`, `lang2`, `rank`, `pos`, `target`, `confidence`)
+select t.`text`, t.`lang2`, c.`key`, json_extract(c.`value`, '$.Pos'), json_extract(c.`value`, '$.Target'), json_extract(c.`value`, '$.Confidence')
+from `azure_translation` t
+,json_each(t.`result`) c;
+
+alter table `azure_translation` drop column `result`;
@@ -0,0 +1,2 @@
+create index `idx_custom_translation_lang2_translated` on `custom_translation`(`lang2`, `translated`);
+create index `idx_azure_translation_candidate_lang2_target` on `azure_translation_candidate`(`lang2`, `target`);
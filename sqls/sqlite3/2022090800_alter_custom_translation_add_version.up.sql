@@ -0,0 +1,15 @@
+create table `custom_translation_new` (
+ `version` int not null default 1
+,`created_at` datetime not null default current_timestamp
+,`updated_at` datetime not null default current_timestamp
+,`text` varchar(30) not null
+,`pos` int not null
+,`lang2` varchar(2) not null
+,`translated` varchar(100) not null
+,`disabled` tinyint(1) not null default 0
+,primary key(`text`, `pos`, `lang2`)
+);
+insert into `custom_translation_new` (`text`, `pos`, `lang2`, `translated`, `disabled`) select `text`, `pos`, `lang2`, `translated`, `disabled` from `custom_translation`;
+drop table `custom_translation`;
+alter table `custom_translation_new` rename to `custom_translation`;
+create index `idx_custom_translation_lang2_translated` on `custom_translation`(`lang2`, `translated`);
@@ -0,0 +1,12 @@
+create table `custom_translation_history` (
+ `text` varchar(30) not null
+,`pos` int not null
+,`lang2` varchar(2) not null
+,`version` int not null
+,`action` varchar(10) not null
+,`actor` varchar(100) not null
+,`old_translated` varchar(100) not null default ''
+,`new_translated` varchar(100) not null default ''
+,`created_at` datetime not null default current_timestamp
+,primary key(`text`, `pos`, `lang2`, `version`, `action`)
+);
@@ -0,0 +1,2 @@
+alter table `custom_translation` add column `senses` text not null default '[]';
+alter table `custom_translation_history` add column `new_senses` text not null default '[]';
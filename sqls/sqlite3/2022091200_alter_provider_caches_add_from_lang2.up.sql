@@ -0,0 +1,66 @@
+create table `azure_translation_new` (
+ `text` varchar(30) not null
+,`from_lang2` varchar(2) not null default 'en'
+,`lang2` varchar(2) not null
+,`expires_at` datetime
+,`created_at` datetime not null default '1970-01-01 00:00:00'
+,`refreshed_at` datetime not null default '1970-01-01 00:00:00'
+,primary key(`text`, `from_lang2`, `lang2`)
+);
+insert into `azure_translation_new` (`text`, `lang2`, `expires_at`, `created_at`, `refreshed_at`)
+select `text`, `lang2`, `expires_at`, `created_at`, `refreshed_at` from `azure_translation`;
+drop table `azure_translation`;
+alter table `azure_translation_new` rename to `azure_translation`;
+create index `idx_azure_translation_refreshed_at` on `azure_translation`(`refreshed_at`);
+
+create table `azure_translation_candidate_new` (
+ `text` varchar(30) not null
+,`from_lang2` varchar(2) not null default 'en'
+,`lang2` varchar(2) not null
+,`rank` int not null
+,`pos` int not null
+,`target` varchar(100) not null
+,`confidence` double not null
+,`normalized_source` varchar(30) not null default ''
+,`prefix_word` varchar(30) not null default ''
+,`back_translations` text not null default '[]'
+,primary key(`text`, `from_lang2`, `lang2`, `rank`)
+);
+insert into `azure_translation_candidate_new` (`text`, `lang2`, `rank`, `pos`, `target`, `confidence`, `normalized_source`, `prefix_word`, `back_translations`)
+select `text`, `lang2`, `rank`, `pos`, `target`, `confidence`, `normalized_source`, `prefix_word`, `back_translations` from `azure_translation_candidate`;
+drop table `azure_translation_candidate`;
+alter table `azure_translation_candidate_new` rename to `azure_translation_candidate`;
+create index `idx_azure_translation_candidate_lang2_pos` on `azure_translation_candidate`(`lang2`, `pos`);
+create index `idx_azure_translation_candidate_lang2_target` on `azure_translation_candidate`(`lang2`, `target`);
+
+create table `azure_example_new` (
+ `text` varchar(30) not null
+,`from_lang2` varchar(2) not null default 'en'
+,`lang2` varchar(2) not null
+,`translated` varchar(100) not null
+,`created_at` datetime not null default current_timestamp
+,primary key(`text`, `from_lang2`, `lang2`, `translated`)
+);
+insert into `azure_example_new` (`text`, `lang2`, `translated`, `created_at`)
+select `text`, `lang2`, `translated`, `created_at` from `azure_example`;
+drop table `azure_example`;
+alter table `azure_example_new` rename to `azure_example`;
+
+create table `azure_example_sentence_new` (
+ `text` varchar(30) not null
+,`from_lang2` varchar(2) not null default 'en'
+,`lang2` varchar(2) not null
+,`translated` varchar(100) not null
+,`rank` int not null
+,`source_prefix` text not null
+,`source_term` varchar(100) not null
+,`source_suffix` text not null
+,`target_prefix` text not null
+,`target_term` varchar(100) not null
+,`target_suffix` text not null
+,primary key(`text`, `from_lang2`, `lang2`, `translated`, `rank`)
+);
+insert into `azure_example_sentence_new` (`text`, `lang2`, `translated`, `rank`, `source_prefix`, `source_term`, `source_suffix`, `target_prefix`, `target_term`, `target_suffix`)
+select `text`, `lang2`, `translated`, `rank`, `source_prefix`, `source_term`, `source_suffix`, `target_prefix`, `target_term`, `target_suffix` from `azure_example_sentence`;
+drop table `azure_example_sentence`;
+alter table `azure_example_sentence_new` rename to `azure_example_sentence`;
@@ -44,6 +44,45 @@ type AuthConfig struct {
 
 type AzureConfig struct {
 	SubscriptionKey string `yaml:"subscriptionKey" validate:"required"`
+	Endpoint        string `yaml:"endpoint" validate:"omitempty,url"`
+	Region          string `yaml:"region"`
+	TimeoutSec      int    `yaml:"timeoutSec" validate:"gte=0"`
+}
+
+type JMdictConfig struct {
+	// Format is jmdict(XML) or edict2(UTF-8)
+	Format string `yaml:"format" validate:"required,oneof=jmdict edict2"`
+	Path   string `yaml:"path" validate:"required"`
+}
+
+type TranslationProviderConfig struct {
+	Name   string `yaml:"name" validate:"required"`
+	Cached bool   `yaml:"cached"`
+}
+
+type TranslationConfig struct {
+	// Providers are looked up in this order after custom translations
+	Providers []*TranslationProviderConfig `yaml:"providers" validate:"required,min=1,dive,required"`
+	// StreamConcurrency is the maximum number of texts which a lookup stream looks up at the same time
+	StreamConcurrency int `yaml:"streamConcurrency" validate:"gte=1"`
+	// NegativeCacheTTLSec is how long "no result" of cached providers are kept. Zero disables negative caching.
+	NegativeCacheTTLSec int `yaml:"negativeCacheTtlSec" validate:"gte=0"`
+	// Refresh is optional. Cached translations are never looked up again if it is not set.
+	Refresh *RefreshConfig `yaml:"refresh"`
+}
+
+type RefreshConfig struct {
+	// MaxAgeSec is how long cached translations are used before they are looked up again
+	MaxAgeSec   int `yaml:"maxAgeSec" validate:"gte=1"`
+	IntervalSec int `yaml:"intervalSec" validate:"gte=1"`
+	// BatchSize is the maximum number of texts of each provider which are looked up again in an interval
+	BatchSize int `yaml:"batchSize" validate:"gte=1"`
+}
+
+type CacheConfig struct {
+	// Size is the maximum number of entries of each of custom translations and provider translations
+	Size   int `yaml:"size" validate:"gte=1"`
+	TTLSec int `yaml:"ttlSec" validate:"gte=1"`
 }
 
 type JaegerConfig struct {
@@ -80,16 +119,19 @@ type DebugConfig struct {
 }
 
 type Config struct {
-	App      *AppConfig      `yaml:"app" validate:"required"`
-	DB       *DBConfig       `yaml:"db" validate:"required"`
-	Auth     *AuthConfig     `yaml:"auth" validate:"required"`
-	Azure    *AzureConfig    `yaml:"azure" validate:"required"`
-	Trace    *TraceConfog    `yaml:"trace" validate:"required"`
-	CORS     *CORSConfig     `yaml:"cors" validate:"required"`
-	Shutdown *ShutdownConfig `yaml:"shutdown" validate:"required"`
-	Log      *LogConfig      `yaml:"log" validate:"required"`
-	Debug    *DebugConfig    `yaml:"debug"`
-	Swagger  *SwaggerConfig  `yaml:"swagger" validate:"required"`
+	App         *AppConfig         `yaml:"app" validate:"required"`
+	DB          *DBConfig          `yaml:"db" validate:"required"`
+	Auth        *AuthConfig        `yaml:"auth" validate:"required"`
+	Azure       *AzureConfig       `yaml:"azure"`
+	JMdict      *JMdictConfig      `yaml:"jmdict"`
+	Translation *TranslationConfig `yaml:"translation" validate:"required"`
+	Cache       *CacheConfig       `yaml:"cache"`
+	Trace       *TraceConfog       `yaml:"trace" validate:"required"`
+	CORS        *CORSConfig        `yaml:"cors" validate:"required"`
+	Shutdown    *ShutdownConfig    `yaml:"shutdown" validate:"required"`
+	Log         *LogConfig         `yaml:"log" validate:"required"`
+	Debug       *DebugConfig       `yaml:"debug"`
+	Swagger     *SwaggerConfig     `yaml:"swagger" validate:"required"`
 }
 
 func LoadConfig(env string) (*Config, error) {
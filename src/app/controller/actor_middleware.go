@@ -0,0 +1,16 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+// newActorMiddleware sets the user authenticated by the basic authentication as the actor of the request context
+func newActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := service.WithActor(c.Request.Context(), c.GetString(gin.AuthUserKey))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
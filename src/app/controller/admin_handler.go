@@ -1,10 +1,12 @@
 package controller
 
 import (
-	"bytes"
 	"encoding/csv"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kujilabo/cocotola-translator-api/src/app/controller/converter"
@@ -24,9 +26,19 @@ type AdminHandler interface {
 	AddTranslation(c *gin.Context)
 	UpdateTranslation(c *gin.Context)
 	RemoveTranslation(c *gin.Context)
+	RestoreTranslation(c *gin.Context)
+	FindDisabledTranslations(c *gin.Context)
+	SearchTranslations(c *gin.Context)
+	FindTranslationHistory(c *gin.Context)
+	RevertTranslation(c *gin.Context)
 	ExportTranslations(c *gin.Context)
+	ImportTranslations(c *gin.Context)
+	FindNegativeCaches(c *gin.Context)
+	RemoveNegativeCaches(c *gin.Context)
 }
 
+var translationImportCSVHeader = []string{"lang2", "text", "pos", "translated"}
+
 type adminHandler struct {
 	adminUsecase usecase.AdminUsecase
 }
@@ -41,11 +53,13 @@ func NewAdminHandler(adminUsecase usecase.AdminUsecase) AdminHandler {
 // @Tags        translator
 // @Accept      json
 // @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translations. v2 only"
 // @Param       param body entity.TranslationFindParameter true "parameter to find translations"
 // @Success     200 {object} entity.TranslationFindResponse
 // @Failure     400
 // @Failure     401
 // @Router      /v1/admin/find [post]
+// @Router      /v2/admin/lang/{lang2}/find [post]
 // @Security    BasicAuth
 func (h *adminHandler) FindTranslationsByFirstLetter(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -53,6 +67,12 @@ func (h *adminHandler) FindTranslationsByFirstLetter(c *gin.Context) {
 	logger.Infof("FindTranslations")
 
 	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		param := entity.TranslationFindParameterHTTPEntity{}
 		if err := c.ShouldBindJSON(&param); err != nil {
 			c.Status(http.StatusBadRequest)
@@ -64,12 +84,12 @@ func (h *adminHandler) FindTranslationsByFirstLetter(c *gin.Context) {
 			return nil
 		}
 
-		results, err := h.adminUsecase.FindTranslationsByFirstLetter(ctx, domain.Lang2JA, param.Letter)
+		results, err := h.adminUsecase.FindTranslationsByFirstLetter(ctx, lang2, param.Letter)
 		if err != nil {
 			return err
 		}
 
-		response, err := converter.ToTranslationFindResposne(ctx, results)
+		response, err := converter.ToAdminTranslationFindResposne(ctx, results)
 		if err != nil {
 			return err
 		}
@@ -85,12 +105,14 @@ func (h *adminHandler) FindTranslationsByFirstLetter(c *gin.Context) {
 // @Tags        translator
 // @Accept      json
 // @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translation. v2 only"
 // @Param       text path string true "text"
 // @Param       pos path int true "pos"
 // @Success     200 {object} entity.Translation
 // @Failure     400
 // @Failure     401
 // @Router      /v1/admin/text/{text}/pos/{pos} [get]
+// @Router      /v2/admin/lang/{lang2}/text/{text}/pos/{pos} [get]
 // @Security    BasicAuth
 func (h *adminHandler) FindTranslationByTextAndPos(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -98,6 +120,12 @@ func (h *adminHandler) FindTranslationByTextAndPos(c *gin.Context) {
 	logger.Infof("FindTranslationByTextAndPos")
 
 	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		text := helper.GetStringFromPath(c, "text")
 
 		pos, err := helper.GetIntFromPath(c, "pos")
@@ -109,7 +137,7 @@ func (h *adminHandler) FindTranslationByTextAndPos(c *gin.Context) {
 		if err != nil {
 			return err
 		}
-		result, err := h.adminUsecase.FindTranslationByTextAndPos(ctx, domain.Lang2JA, text, wordPos)
+		result, err := h.adminUsecase.FindTranslationByTextAndPos(ctx, lang2, text, wordPos)
 		if err != nil {
 			return err
 		}
@@ -119,6 +147,9 @@ func (h *adminHandler) FindTranslationByTextAndPos(c *gin.Context) {
 			return err
 		}
 
+		if result.GetProvider() == service.TranslationProviderCustom {
+			c.Header(headerETag, versionToETag(result.GetVersion()))
+		}
 		c.JSON(http.StatusOK, response)
 		return nil
 	}, h.errorHandle)
@@ -130,23 +161,31 @@ func (h *adminHandler) FindTranslationByTextAndPos(c *gin.Context) {
 // @Tags        translator
 // @Accept      json
 // @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translations. v2 only"
 // @Param       text path string true "text"
 // @Success     200 {object} entity.Translation
 // @Failure     400
 // @Failure     401
 // @Router      /v1/admin/text/{text} [get]
+// @Router      /v2/admin/lang/{lang2}/text/{text} [get]
 // @Security    BasicAuth
 func (h *adminHandler) FindTranslationsByText(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		text := helper.GetStringFromPath(c, "text")
-		results, err := h.adminUsecase.FindTranslationByText(ctx, domain.Lang2JA, text)
+		results, err := h.adminUsecase.FindTranslationByText(ctx, lang2, text)
 		if err != nil {
 			return err
 		}
 
-		response, err := converter.ToTranslationFindResposne(ctx, results)
+		response, err := converter.ToAdminTranslationFindResposne(ctx, results)
 		if err != nil {
 			return err
 		}
@@ -167,7 +206,15 @@ func (h *adminHandler) AddTranslation(c *gin.Context) {
 		}
 		parameter, err := converter.ToTranslationAddParameter(ctx, &param)
 		if err != nil {
-			return err
+			// the joined glosses may exceed the length of translated
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		// the language of v2 paths has to be the same as the body
+		if lang2 := helper.GetStringFromPath(c, lang2Param); lang2 != "" && lang2 != param.Lang2 {
+			c.Status(http.StatusBadRequest)
+			return nil
 		}
 
 		if err := h.adminUsecase.AddTranslation(ctx, parameter); err != nil {
@@ -183,6 +230,12 @@ func (h *adminHandler) UpdateTranslation(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		text := helper.GetStringFromPath(c, "text")
 
 		pos, err := helper.GetIntFromPath(c, "pos")
@@ -194,17 +247,25 @@ func (h *adminHandler) UpdateTranslation(c *gin.Context) {
 			return err
 		}
 
+		version, err := getVersionFromIfMatch(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		param := entity.TranslationUpdateParameterHTTPEntity{}
 		if err := c.ShouldBindJSON(&param); err != nil {
 			c.Status(http.StatusBadRequest)
 			return nil
 		}
-		parameter, err := converter.ToTranslationUpdateParameter(ctx, &param)
+		parameter, err := converter.ToTranslationUpdateParameter(ctx, &param, version)
 		if err != nil {
-			return err
+			// the joined glosses may exceed the length of translated
+			c.Status(http.StatusBadRequest)
+			return nil
 		}
 
-		if err := h.adminUsecase.UpdateTranslation(ctx, domain.Lang2JA, text, wordPos, parameter); err != nil {
+		if err := h.adminUsecase.UpdateTranslation(ctx, lang2, text, wordPos, parameter); err != nil {
 			return err
 		}
 
@@ -217,6 +278,12 @@ func (h *adminHandler) RemoveTranslation(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		text := helper.GetStringFromPath(c, "text")
 
 		pos, err := helper.GetIntFromPath(c, "pos")
@@ -228,7 +295,13 @@ func (h *adminHandler) RemoveTranslation(c *gin.Context) {
 			return err
 		}
 
-		if err := h.adminUsecase.RemoveTranslation(ctx, domain.Lang2JA, text, wordPos); err != nil {
+		version, err := getVersionFromIfMatch(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		if err := h.adminUsecase.RemoveTranslation(ctx, lang2, text, wordPos, version); err != nil {
 			return err
 		}
 
@@ -237,26 +310,485 @@ func (h *adminHandler) RemoveTranslation(c *gin.Context) {
 	}, h.errorHandle)
 }
 
-func (h *adminHandler) ExportTranslations(c *gin.Context) {
+// RestoreTranslation godoc
+// @Summary     restore a removed custom translation
+// @Description enable a removed custom translation. The translations of the providers are shown again if it has no translated text
+// @Tags        translator
+// @Param       lang2 path string true "ISO 639-1 code of the translation. v2 only"
+// @Param       text path string true "text"
+// @Param       pos path int true "pos"
+// @Param       If-Match header string false "ETag of the translation"
+// @Success     200
+// @Failure     400
+// @Failure     401
+// @Failure     404
+// @Failure     409
+// @Router      /v1/admin/text/{text}/pos/{pos}/restore [post]
+// @Router      /v2/admin/lang/{lang2}/text/{text}/pos/{pos}/restore [post]
+// @Security    BasicAuth
+func (h *adminHandler) RestoreTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		text := helper.GetStringFromPath(c, "text")
+
+		pos, err := helper.GetIntFromPath(c, "pos")
+		if err != nil {
+			return err
+		}
+		wordPos, err := domain.NewWordPos(pos)
+		if err != nil {
+			return err
+		}
+
+		version, err := getVersionFromIfMatch(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		if err := h.adminUsecase.RestoreTranslation(ctx, lang2, text, wordPos, version); err != nil {
+			return err
+		}
+
+		c.Status(http.StatusOK)
+		return nil
+	}, h.errorHandle)
+}
+
+// FindDisabledTranslations godoc
+// @Summary     find removed custom translations
+// @Description find removed custom translations which suppress the translations of the providers
+// @Tags        translator
+// @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translations. v2 only"
+// @Success     200 {object} entity.TranslationFindResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/disabled [get]
+// @Router      /v2/admin/lang/{lang2}/disabled [get]
+// @Security    BasicAuth
+func (h *adminHandler) FindDisabledTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		results, err := h.adminUsecase.FindDisabledTranslations(ctx, lang2)
+		if err != nil {
+			return err
+		}
+
+		response, err := converter.ToAdminTranslationFindResposne(ctx, results)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// SearchTranslations godoc
+// @Summary     search custom translations
+// @Description search custom translations by tag and level. removed ones are not included
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translations. v2 only"
+// @Param       param body entity.TranslationSearchParameterHTTPEntity true "parameter to search translations"
+// @Success     200 {object} entity.TranslationSearchResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/search [post]
+// @Router      /v2/admin/lang/{lang2}/search [post]
+// @Security    BasicAuth
+func (h *adminHandler) SearchTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		param := entity.TranslationSearchParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		result, err := h.adminUsecase.SearchTranslations(ctx, lang2, &service.CustomTranslationSearchCondition{
+			Tag:      strings.ToLower(param.Tag),
+			Level:    param.Level,
+			PageNo:   param.PageNo,
+			PageSize: param.PageSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		response, err := converter.ToTranslationSearchResponse(ctx, result)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// FindTranslationHistory godoc
+// @Summary     find the history of a custom translation
+// @Description find the changes of a custom translation in descending order of the version
+// @Tags        translator
+// @Produce     json
+// @Param       lang2 path string true "ISO 639-1 code of the translation. v2 only"
+// @Param       text path string true "text"
+// @Param       pos path int true "pos"
+// @Success     200 {object} entity.TranslationHistoryResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/text/{text}/pos/{pos}/history [get]
+// @Router      /v2/admin/lang/{lang2}/text/{text}/pos/{pos}/history [get]
+// @Security    BasicAuth
+func (h *adminHandler) FindTranslationHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		text := helper.GetStringFromPath(c, "text")
+
+		pos, err := helper.GetIntFromPath(c, "pos")
+		if err != nil {
+			return err
+		}
+		wordPos, err := domain.NewWordPos(pos)
+		if err != nil {
+			return err
+		}
+
+		results, err := h.adminUsecase.FindTranslationHistory(ctx, lang2, text, wordPos)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, converter.ToTranslationHistoryResponse(ctx, results))
+		return nil
+	}, h.errorHandle)
+}
+
+// RevertTranslation godoc
+// @Summary     revert a custom translation
+// @Description change a custom translation back to a version in its history
+// @Tags        translator
+// @Accept      json
+// @Param       lang2 path string true "ISO 639-1 code of the translation. v2 only"
+// @Param       text path string true "text"
+// @Param       pos path int true "pos"
+// @Param       If-Match header string false "ETag of the translation"
+// @Param       param body entity.TranslationRevertParameterHTTPEntity true "parameter to revert the translation"
+// @Success     200
+// @Failure     400
+// @Failure     401
+// @Failure     404
+// @Failure     409
+// @Router      /v1/admin/text/{text}/pos/{pos}/revert [post]
+// @Router      /v2/admin/lang/{lang2}/text/{text}/pos/{pos}/revert [post]
+// @Security    BasicAuth
+func (h *adminHandler) RevertTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+
 	handlerhelper.HandleFunction(c, func() error {
-		csvStruct := [][]string{
-			{"name", "address", "phone"},
-			{"Ram", "Tokyo", "1236524"},
-			{"Shaym", "Beijing", "8575675484"},
+		lang2, err := getLang2FromPath(c, lang2Param, domain.Lang2JA)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		text := helper.GetStringFromPath(c, "text")
+
+		pos, err := helper.GetIntFromPath(c, "pos")
+		if err != nil {
+			return err
 		}
-		b := new(bytes.Buffer)
-		w := csv.NewWriter(b)
-		if err := w.WriteAll(csvStruct); err != nil {
+		wordPos, err := domain.NewWordPos(pos)
+		if err != nil {
 			return err
 		}
-		if _, err := c.Writer.Write(b.Bytes()); err != nil {
+
+		version, err := getVersionFromIfMatch(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		param := entity.TranslationRevertParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		if err := h.adminUsecase.RevertTranslation(ctx, lang2, text, wordPos, param.Version, version); err != nil {
 			return err
 		}
+
 		c.Status(http.StatusOK)
 		return nil
 	}, h.errorHandle)
 }
 
+// ExportTranslations godoc
+// @Summary     export translations
+// @Description export custom and azure translations as CSV or TSV whose columns are lang2, text, pos, translated, provider, version and updated_at
+// @Tags        translator
+// @Accept      json
+// @Produce     text/csv
+// @Produce     text/tab-separated-values
+// @Param       param body entity.TranslationExportParameterHTTPEntity true "parameter to export translations"
+// @Success     200
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/export [post]
+// @Router      /v2/admin/export [post]
+// @Security    BasicAuth
+func (h *adminHandler) ExportTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+	logger := log.FromContext(ctx)
+
+	handlerhelper.HandleFunction(c, func() error {
+		param := entity.TranslationExportParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		lang2, err := domain.NewLang2(param.Lang2)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		comma := ','
+		contentType := "text/csv"
+		fileName := "translations.csv"
+		if param.Format == "tsv" {
+			comma = '\t'
+			contentType = "text/tab-separated-values"
+			fileName = "translations.tsv"
+		}
+
+		c.Header("Content-Type", contentType+"; charset=utf-8")
+		c.Header("Content-Disposition", "attachment; filename="+fileName)
+		c.Status(http.StatusOK)
+
+		presenter, err := newTranslationCSVPresenter(c.Writer, comma)
+		if err != nil {
+			return err
+		}
+
+		if err := h.adminUsecase.ExportTranslations(ctx, lang2, presenter); err != nil {
+			// the status code has already been sent, so the response is just cut off
+			logger.Errorf("failed to ExportTranslations. err: %v", err)
+			return nil
+		}
+		return nil
+	}, h.errorHandle)
+}
+
+// ImportTranslations godoc
+// @Summary     import translations
+// @Description import custom translations from a CSV file whose columns are lang2, text, pos and translated. The following columns are ignored
+// @Tags        translator
+// @Accept      multipart/form-data
+// @Produce     json
+// @Param       file formData file true "CSV file"
+// @Param       dryRun formData bool false "validate rows without writing"
+// @Success     200 {object} entity.TranslationImportResponseHTTPEntity
+// @Failure     400 {object} entity.TranslationImportResponseHTTPEntity
+// @Failure     401
+// @Router      /v1/admin/import [post]
+// @Router      /v2/admin/import [post]
+// @Security    BasicAuth
+func (h *adminHandler) ImportTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		dryRun := false
+		if dryRunS := c.PostForm("dryRun"); len(dryRunS) != 0 {
+			dryRun, err = strconv.ParseBool(dryRunS)
+			if err != nil {
+				c.Status(http.StatusBadRequest)
+				return nil
+			}
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		rows, err := h.parseTranslationImportCSV(file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return nil
+		}
+
+		results, err := h.adminUsecase.ImportTranslations(ctx, rows, dryRun)
+		if err != nil {
+			return err
+		}
+
+		response := converter.ToTranslationImportResponse(ctx, results)
+		if !results.DryRun && !results.Committed {
+			c.JSON(http.StatusBadRequest, response)
+			return nil
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// parseTranslationImportCSV reads rows of lang2, text, pos and translated. The header row is optional.
+// The columns after translated are ignored, so the CSV of ExportTranslations can be imported.
+func (h *adminHandler) parseTranslationImportCSV(r io.Reader) ([]usecase.TranslationImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows := make([]usecase.TranslationImportRow, 0)
+	for i := 0; ; i++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < len(translationImportCSVHeader) {
+			line, _ := reader.FieldPos(0)
+			return nil, &csv.ParseError{StartLine: line, Line: line, Err: csv.ErrFieldCount}
+		}
+
+		if i == 0 && strings.EqualFold(record[0], translationImportCSVHeader[0]) {
+			continue
+		}
+
+		// invalid pos is reported as an invalid row by the usecase
+		pos, err := strconv.Atoi(record[2])
+		if err != nil {
+			pos = 0
+		}
+
+		rows = append(rows, usecase.TranslationImportRow{
+			Lang2:      record[0],
+			Text:       record[1],
+			Pos:        pos,
+			Translated: record[3],
+		})
+	}
+	return rows, nil
+}
+
+// FindNegativeCaches godoc
+// @Summary     find negative caches
+// @Description find the texts which azure has no translations of
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       param body entity.NegativeCacheFindParameterHTTPEntity true "parameter to find negative caches"
+// @Success     200 {object} entity.NegativeCacheFindResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/negative/find [post]
+// @Router      /v2/admin/negative/find [post]
+// @Security    BasicAuth
+func (h *adminHandler) FindNegativeCaches(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		param := entity.NegativeCacheFindParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		lang2, err := domain.NewLang2(param.Lang2)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		result, err := h.adminUsecase.FindNegativeCaches(ctx, lang2, &service.TranslationSearchCondition{
+			PageNo:   param.PageNo,
+			PageSize: param.PageSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, converter.ToNegativeCacheFindResponse(ctx, result))
+		return nil
+	}, h.errorHandle)
+}
+
+// RemoveNegativeCaches godoc
+// @Summary     remove negative caches
+// @Description remove the negative caches of the text. all the negative caches of lang2 are removed if text is empty
+// @Tags        translator
+// @Produce     json
+// @Param       lang2 query string true "lang2"
+// @Param       text query string false "text"
+// @Success     200 {object} entity.NegativeCacheRemoveResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/admin/negative [delete]
+// @Router      /v2/admin/negative [delete]
+// @Security    BasicAuth
+func (h *adminHandler) RemoveNegativeCaches(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	handlerhelper.HandleFunction(c, func() error {
+		lang2, err := domain.NewLang2(helper.GetStringFromQuery(c, "lang2"))
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		removed, err := h.adminUsecase.RemoveNegativeCaches(ctx, lang2, helper.GetStringFromQuery(c, "text"))
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, entity.NegativeCacheRemoveResponseHTTPEntity{Removed: removed})
+		return nil
+	}, h.errorHandle)
+}
+
 func (h *adminHandler) errorHandle(c *gin.Context, err error) bool {
 	ctx := c.Request.Context()
 	logger := log.FromContext(ctx)
@@ -266,6 +798,16 @@ func (h *adminHandler) errorHandle(c *gin.Context, err error) bool {
 		c.JSON(http.StatusConflict, gin.H{"message": "Translation already exists"})
 		return true
 	}
+	if errors.Is(err, service.ErrVersionConflict) {
+		logger.Warnf("adminHandler. err: %v", err)
+		c.JSON(http.StatusConflict, gin.H{"message": "Translation has been modified"})
+		return true
+	}
+	if errors.Is(err, service.ErrTranslationNotFound) {
+		logger.Warnf("adminHandler. err: %v", err)
+		c.JSON(http.StatusNotFound, gin.H{"message": "Translation not found"})
+		return true
+	}
 	logger.Errorf("adminHandler. err: %v", err)
 	return false
 }
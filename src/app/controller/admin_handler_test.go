@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +24,7 @@ import (
 	"github.com/kujilabo/cocotola-translator-api/src/app/config"
 	"github.com/kujilabo/cocotola-translator-api/src/app/controller"
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
 	usecase_mock "github.com/kujilabo/cocotola-translator-api/src/app/usecase/mock"
 )
@@ -91,6 +95,62 @@ func Test_adminHandler_FindTranslationsByFirstLetter_OK(t *testing.T) {
 	assert.Equal(t, "ja", lang2[0].(string))
 }
 
+func Test_adminHandler_FindTranslationsByFirstLetter_V2(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	ko, err := domain.NewLang2("ko")
+	require.NoError(t, err)
+	apple, err := domain.NewTranslation(1, time.Now(), time.Now(), "apple", domain.PosNoun, ko, "사과", "mock")
+	require.NoError(t, err)
+	adminUsecase.On("FindTranslationsByFirstLetter", anythingOfContext, ko, "a").Return([]domain.Translation{apple}, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "korean", path: "/v2/admin/lang/ko/find", wantStatus: http.StatusOK},
+		{name: "unknown language", path: "/v2/admin/lang/xx/find", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			body, err := json.Marshal(gin.H{"letter": "a"})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, tt.path, bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+	adminUsecase.AssertNumberOfCalls(t, "FindTranslationsByFirstLetter", 1)
+}
+
+func Test_adminHandler_AddTranslation_V2_LangMismatch(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	// - the language of the path is different from the body
+	body, err := json.Marshal(gin.H{"lang2": "ja", "text": "apple", "pos": int(domain.PosNoun), "translated": "りんご"})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/v2/admin/lang/ko", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	adminUsecase.AssertNotCalled(t, "AddTranslation")
+}
+
 func Test_adminHandler_FindTranslationsByFirstLetter_LetterIsNothing(t *testing.T) {
 	// given
 	adminUsecase := new(usecase_mock.AdminUsecase)
@@ -131,3 +191,653 @@ func Test_adminHandler_FindTranslationsByFirstLetter_LetterIsNothing(t *testing.
 	// lang2 := lang2Expr.Get(jsonObj)
 	// assert.Equal(t, "ja", lang2[0].(string))
 }
+
+func Test_adminHandler_ExportTranslations_TSV(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+
+	updatedAt := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	book, err := domain.NewTranslation(2, updatedAt, updatedAt, "book", domain.PosNoun, domain.Lang2JA, "本", "custom")
+	require.NoError(t, err)
+	adminUsecase.On("ExportTranslations", anythingOfContext, domain.Lang2JA, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		presenter := args.Get(2).(usecase.AdminPresenter)
+		require.NoError(t, presenter.WriteTranslations(context.Background(), []domain.Translation{book}))
+	})
+
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	body, err := json.Marshal(gin.H{"lang2": "ja", "format": "tsv"})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/admin/export", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/tab-separated-values; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "lang2\ttext\tpos\ttranslated\tprovider\tversion\tupdated_at\nja\tbook\t6\t本\tcustom\t2\t2022-08-01T12:00:00Z\n", w.Body.String())
+}
+
+func Test_adminHandler_ExportTranslations_InvalidFormat(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	body, err := json.Marshal(gin.H{"lang2": "ja", "format": "xlsx"})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/admin/export", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func Test_adminHandler_ImportTranslations_DryRun(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	rows := []usecase.TranslationImportRow{
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosNoun), Translated: "本"},
+		{Lang2: "ja", Text: "book", Pos: 0, Translated: "予約する"},
+	}
+	adminUsecase.On("ImportTranslations", anythingOfContext, rows, true).Return(&usecase.TranslationImportResults{
+		DryRun: true,
+		Results: []usecase.TranslationImportResult{
+			{RowNo: 1, Lang2: "ja", Text: "book", Pos: int(domain.PosNoun), Status: usecase.TranslationImportStatusCreated},
+			{RowNo: 2, Lang2: "ja", Text: "book", Pos: 0, Status: usecase.TranslationImportStatusInvalid, Message: "invalid pos"},
+		},
+	}, nil)
+
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	// - the second row has an invalid pos
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	fw, err := mw.CreateFormFile("file", "translations.csv")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("lang2,text,pos,translated\nja,book,6,本\nja,book,verb,予約する\n"))
+	require.NoError(t, err)
+	require.NoError(t, mw.WriteField("dryRun", "true"))
+	require.NoError(t, mw.Close())
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/admin/import", body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	statuses := parseExpr(t, "$.results[*].status").Get(jsonObj)
+	assert.Equal(t, []interface{}{"created", "invalid"}, statuses)
+}
+
+func Test_adminHandler_ImportTranslations_Exported(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	updatedAt := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	book, err := domain.NewTranslation(2, updatedAt, updatedAt, "book", domain.PosNoun, domain.Lang2JA, "本", "custom")
+	require.NoError(t, err)
+	adminUsecase.On("ExportTranslations", anythingOfContext, domain.Lang2JA, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		presenter := args.Get(2).(usecase.AdminPresenter)
+		require.NoError(t, presenter.WriteTranslations(context.Background(), []domain.Translation{book}))
+	})
+	rows := []usecase.TranslationImportRow{
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosNoun), Translated: "本"},
+	}
+	adminUsecase.On("ImportTranslations", anythingOfContext, rows, true).Return(&usecase.TranslationImportResults{
+		DryRun: true,
+		Results: []usecase.TranslationImportResult{
+			{RowNo: 1, Lang2: "ja", Text: "book", Pos: int(domain.PosNoun), Status: usecase.TranslationImportStatusUnchanged},
+		},
+	}, nil)
+
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// - the translations are exported as CSV
+	exportBody, err := json.Marshal(gin.H{"lang2": "ja", "format": "csv"})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/v1/admin/export", bytes.NewBuffer(exportBody))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// when
+	// - the exported CSV is imported as it is
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	fw, err := mw.CreateFormFile("file", "translations.csv")
+	require.NoError(t, err)
+	_, err = fw.Write(w.Body.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, mw.WriteField("dryRun", "true"))
+	require.NoError(t, mw.Close())
+
+	req, err = http.NewRequest(http.MethodPost, "/v1/admin/import", body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.SetBasicAuth("user", "pass")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - the header is skipped and the columns after translated are ignored
+	assert.Equal(t, http.StatusOK, w.Code)
+	adminUsecase.AssertCalled(t, "ImportTranslations", anythingOfContext, rows, true)
+}
+
+func Test_adminHandler_FindNegativeCaches(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	expiresAt := time.Date(2022, 9, 8, 0, 0, 0, 0, time.UTC)
+	adminUsecase.On("FindNegativeCaches", anythingOfContext, domain.Lang2JA, &service.TranslationSearchCondition{PageNo: 1, PageSize: 10}).Return(&service.NegativeCacheSearchResult{
+		TotalCount: 1,
+		Results: []service.NegativeCacheEntry{
+			{Lang2: domain.Lang2JA, Text: "xyz", ExpiresAt: expiresAt},
+		},
+	}, nil)
+
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	body, err := json.Marshal(gin.H{"lang2": "ja", "pageNo": 1, "pageSize": 10})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/v1/admin/negative/find", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{int64(1)}, parseExpr(t, "$.totalCount").Get(jsonObj))
+	assert.Equal(t, []interface{}{"xyz"}, parseExpr(t, "$.results[*].text").Get(jsonObj))
+	assert.Equal(t, []interface{}{"2022-09-08T00:00:00Z"}, parseExpr(t, "$.results[*].expiresAt").Get(jsonObj))
+}
+
+func Test_adminHandler_RemoveNegativeCaches(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RemoveNegativeCaches", anythingOfContext, domain.Lang2JA, "xyz").Return(int64(1), nil)
+	adminUsecase.On("RemoveNegativeCaches", anythingOfContext, domain.Lang2JA, "").Return(int64(3), nil)
+
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name       string
+		query      string
+		wantCode   int
+		wantRemove []interface{}
+	}{
+		{name: "text", query: "lang2=ja&text=xyz", wantCode: http.StatusOK, wantRemove: []interface{}{int64(1)}},
+		{name: "all", query: "lang2=ja", wantCode: http.StatusOK, wantRemove: []interface{}{int64(3)}},
+		{name: "invalid lang2", query: "lang2=jpn", wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodDelete, "/v1/admin/negative?"+tt.query, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantRemove != nil {
+				jsonObj := parseJSON(t, w.Body)
+				assert.Equal(t, tt.wantRemove, parseExpr(t, "$.removed").Get(jsonObj))
+			}
+		})
+	}
+}
+
+func Test_adminHandler_FindTranslationByTextAndPos_ETag(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	book, err := domain.NewTranslation(3, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", service.TranslationProviderCustom)
+	require.NoError(t, err)
+	adminUsecase.On("FindTranslationByTextAndPos", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun).Return(book, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/admin/text/book/pos/%d", domain.PosNoun), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - the version of the custom translation is the entity tag
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{int64(3)}, parseExpr(t, "$.version").Get(jsonObj))
+}
+
+func Test_adminHandler_UpdateTranslation_IfMatch(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	v0, err := service.NewTransaltionUpdateParameter("本", 0)
+	require.NoError(t, err)
+	v3, err := service.NewTransaltionUpdateParameter("本", 3)
+	require.NoError(t, err)
+	v4, err := service.NewTransaltionUpdateParameter("本", 4)
+	require.NoError(t, err)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, v0).Return(nil)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, v3).Return(nil)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, v4).Return(service.ErrVersionConflict)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name     string
+		ifMatch  string
+		wantCode int
+	}{
+		{name: "without If-Match", ifMatch: "", wantCode: http.StatusOK},
+		{name: "any version", ifMatch: "*", wantCode: http.StatusOK},
+		{name: "latest version", ifMatch: `"3"`, wantCode: http.StatusOK},
+		{name: "stale version", ifMatch: `"4"`, wantCode: http.StatusConflict},
+		{name: "unquoted version", ifMatch: "3", wantCode: http.StatusBadRequest},
+		{name: "invalid version", ifMatch: `"abc"`, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			body, err := json.Marshal(gin.H{"translated": "本"})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/v2/admin/lang/ja/text/book/pos/%d", domain.PosNoun), bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
+
+func Test_adminHandler_RemoveTranslation_IfMatch(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RemoveTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 3).Return(nil)
+	adminUsecase.On("RemoveTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 4).Return(service.ErrVersionConflict)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name     string
+		ifMatch  string
+		wantCode int
+	}{
+		{name: "latest version", ifMatch: `"3"`, wantCode: http.StatusOK},
+		{name: "stale version", ifMatch: `"4"`, wantCode: http.StatusConflict},
+		{name: "zero version", ifMatch: `"0"`, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/v1/admin/text/book/pos/%d", domain.PosNoun), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			req.Header.Set("If-Match", tt.ifMatch)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
+
+func Test_adminHandler_FindTranslationHistory(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("FindTranslationHistory", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun).Return([]service.CustomTranslationHistory{
+		{Version: 2, Action: service.CustomTranslationActionUpdate, Actor: "user", OldTranslated: "本", NewTranslated: "書籍", CreatedAt: time.Now()},
+		{Version: 1, Action: service.CustomTranslationActionAdd, Actor: "user", NewTranslated: "本", CreatedAt: time.Now()},
+	}, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v2/admin/lang/ja/text/book/pos/%d/history", domain.PosNoun), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{int64(2), int64(1)}, parseExpr(t, "$.results[*].version").Get(jsonObj))
+	assert.Equal(t, []interface{}{"本"}, parseExpr(t, "$.results[*].oldTranslated").Get(jsonObj))
+}
+
+func Test_adminHandler_RevertTranslation(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	// - the authenticated user is the actor
+	actorIsUser := mock.MatchedBy(func(ctx context.Context) bool {
+		return service.ActorFromContext(ctx) == "user"
+	})
+	adminUsecase.On("RevertTranslation", actorIsUser, domain.Lang2JA, "book", domain.PosNoun, 1, 2).Return(nil)
+	adminUsecase.On("RevertTranslation", actorIsUser, domain.Lang2JA, "book", domain.PosNoun, 5, 0).Return(service.ErrTranslationNotFound)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name     string
+		body     gin.H
+		ifMatch  string
+		wantCode int
+	}{
+		{name: "revert", body: gin.H{"version": 1}, ifMatch: `"2"`, wantCode: http.StatusOK},
+		{name: "unknown version", body: gin.H{"version": 5}, wantCode: http.StatusNotFound},
+		{name: "no version", body: gin.H{}, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			body, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v1/admin/text/book/pos/%d/revert", domain.PosNoun), bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
+
+func Test_adminHandler_RestoreTranslation(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RestoreTranslation", anythingOfContext, domain.Lang2JA, "can", domain.PosVerb, 2).Return(nil)
+	adminUsecase.On("RestoreTranslation", anythingOfContext, domain.Lang2JA, "can", domain.PosNoun, 0).Return(service.ErrTranslationNotFound)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name     string
+		pos      domain.WordPos
+		ifMatch  string
+		wantCode int
+	}{
+		{name: "disabled", pos: domain.PosVerb, ifMatch: `"2"`, wantCode: http.StatusOK},
+		{name: "not disabled", pos: domain.PosNoun, wantCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("/v2/admin/lang/ja/text/can/pos/%d/restore", tt.pos), nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+		})
+	}
+}
+
+func Test_adminHandler_FindDisabledTranslations(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	canVerb, err := domain.NewDisabledTranslation(2, time.Now(), time.Now(), "can", domain.PosVerb, domain.Lang2JA, "", service.TranslationProviderCustom, domain.TranslationDetail{})
+	require.NoError(t, err)
+	adminUsecase.On("FindDisabledTranslations", anythingOfContext, domain.Lang2JA).Return([]domain.Translation{canVerb}, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, "/v1/admin/disabled", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{"can"}, parseExpr(t, "$.results[*].text").Get(jsonObj))
+	assert.Equal(t, []interface{}{true}, parseExpr(t, "$.results[*].disabled").Get(jsonObj))
+	assert.Equal(t, []interface{}{int64(2)}, parseExpr(t, "$.results[*].version").Get(jsonObj))
+}
+
+func Test_adminHandler_AddTranslation_Senses(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("AddTranslation", anythingOfContext, mock.Anything).Return(nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name           string
+		body           gin.H
+		wantCode       int
+		wantTranslated string
+		wantSenses     []domain.Sense
+	}{
+		{
+			name:           "translated",
+			body:           gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "translated": "本"},
+			wantCode:       http.StatusOK,
+			wantTranslated: "本",
+			wantSenses:     []domain.Sense{{Gloss: "本", Order: 1}},
+		},
+		{
+			name: "senses",
+			body: gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "senses": []gin.H{
+				{"gloss": "書物", "note": "literary", "order": 2},
+				{"gloss": "本", "order": 1},
+			}},
+			wantCode:       http.StatusOK,
+			wantTranslated: "本, 書物",
+			wantSenses:     []domain.Sense{{Gloss: "本", Order: 1}, {Gloss: "書物", Note: "literary", Order: 2}},
+		},
+		{
+			name:     "neither translated nor senses",
+			body:     gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun)},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "empty senses",
+			body:     gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "senses": []gin.H{}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "sense without gloss",
+			body:     gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "senses": []gin.H{{"note": "literary"}}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too long translated",
+			body:     gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "translated": strings.Repeat("本", 101)},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too long note of sense",
+			body:     gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "senses": []gin.H{{"gloss": "本", "note": strings.Repeat("a", 201)}}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "too long joined glosses",
+			body: gin.H{"lang2": "ja", "text": "book", "pos": int(domain.PosNoun), "senses": []gin.H{
+				{"gloss": strings.Repeat("本", 50), "order": 1},
+				{"gloss": strings.Repeat("書", 50), "order": 2},
+			}},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			adminUsecase.Calls = nil
+
+			// when
+			body, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/v1/admin", bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusOK {
+				adminUsecase.AssertNotCalled(t, "AddTranslation", mock.Anything, mock.Anything)
+				return
+			}
+			param := adminUsecase.Calls[0].Arguments.Get(1).(service.TranslationAddParameter)
+			assert.Equal(t, tt.wantTranslated, param.GetTranslated())
+			assert.Equal(t, tt.wantSenses, param.GetSenses())
+		})
+	}
+}
+
+func Test_adminHandler_FindTranslationByTextAndPos_Senses(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	book, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本, 書物", service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Senses:     []domain.Sense{{Gloss: "本", Order: 1}, {Gloss: "書物", Note: "literary", Order: 2}},
+	})
+	require.NoError(t, err)
+	adminUsecase.On("FindTranslationByTextAndPos", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun).Return(book, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/v1/admin/text/book/pos/%d", domain.PosNoun), nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{"本", "書物"}, parseExpr(t, "$.senses[*].gloss").Get(jsonObj))
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, parseExpr(t, "$.senses[*].order").Get(jsonObj))
+	assert.Equal(t, []interface{}{"literary"}, parseExpr(t, "$.senses[1].note").Get(jsonObj))
+}
+
+func Test_adminHandler_SearchTranslations(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	book, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Annotation: domain.TranslationAnnotation{Note: "checked", Tags: []string{"business"}, Level: "A1"},
+	})
+	require.NoError(t, err)
+	adminUsecase.On("SearchTranslations", anythingOfContext, domain.Lang2JA, &service.CustomTranslationSearchCondition{Tag: "business", Level: "A1", PageNo: 1, PageSize: 10}).Return(&service.CustomTranslationSearchResult{
+		TotalCount: 1,
+		Results:    []domain.Translation{book},
+	}, nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	tests := []struct {
+		name     string
+		path     string
+		body     gin.H
+		wantCode int
+	}{
+		{name: "v1", path: "/v1/admin/search", body: gin.H{"tag": "Business", "level": "A1", "pageNo": 1, "pageSize": 10}, wantCode: http.StatusOK},
+		{name: "v2", path: "/v2/admin/lang/ja/search", body: gin.H{"tag": "business", "level": "A1", "pageNo": 1, "pageSize": 10}, wantCode: http.StatusOK},
+		{name: "invalid level", path: "/v1/admin/search", body: gin.H{"level": "D1", "pageNo": 1, "pageSize": 10}, wantCode: http.StatusBadRequest},
+		{name: "no page", path: "/v1/admin/search", body: gin.H{"tag": "business"}, wantCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			body, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, tt.path, bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			jsonObj := parseJSON(t, w.Body)
+			assert.Equal(t, []interface{}{int64(1)}, parseExpr(t, "$.totalCount").Get(jsonObj))
+			assert.Equal(t, []interface{}{"book"}, parseExpr(t, "$.results[*].text").Get(jsonObj))
+			// - the editor note is shown in the admin APIs
+			assert.Equal(t, []interface{}{"checked"}, parseExpr(t, "$.results[*].note").Get(jsonObj))
+		})
+	}
+	adminUsecase.AssertNumberOfCalls(t, "SearchTranslations", 2)
+}
+
+func Test_adminHandler_UpdateTranslation_Annotation(t *testing.T) {
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, mock.Anything).Return(nil)
+	r := initAdminRouter(adminUsecase, initCrosConfig())
+
+	// when
+	body, err := json.Marshal(gin.H{"translated": "本", "note": " checked ", "labels": []string{"Formal", "formal"}, "tags": []string{"business"}, "level": "N5"})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("/v1/admin/text/book/pos/%d", domain.PosNoun), bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - the annotation is normalized
+	assert.Equal(t, http.StatusOK, w.Code)
+	param := adminUsecase.Calls[0].Arguments.Get(4).(service.TranslationUpdateParameter)
+	assert.Equal(t, domain.TranslationAnnotation{Note: "checked", Labels: []string{"formal"}, Tags: []string{"business"}, Level: "N5"}, param.GetAnnotationUpdate().Apply(domain.TranslationAnnotation{}))
+
+	// when
+	// - the note and the labels are omitted, and the tags and the level are empty
+	body, err = json.Marshal(gin.H{"translated": "本", "tags": []string{}, "level": ""})
+	require.NoError(t, err)
+	req, err = http.NewRequest(http.MethodPut, fmt.Sprintf("/v1/admin/text/book/pos/%d", domain.PosNoun), bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - the omitted fields are kept and the empty ones are removed
+	assert.Equal(t, http.StatusOK, w.Code)
+	param = adminUsecase.Calls[1].Arguments.Get(4).(service.TranslationUpdateParameter)
+	current := domain.TranslationAnnotation{Note: "checked", Labels: []string{"formal"}, Tags: []string{"business"}, Level: "N5"}
+	assert.Equal(t, domain.TranslationAnnotation{Note: "checked", Labels: []string{"formal"}, Tags: []string{}, Level: ""}, param.GetAnnotationUpdate().Apply(current))
+}
@@ -1,9 +1,477 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+	libD "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
 	pb "github.com/kujilabo/cocotola-translator-api/src/proto"
 )
 
 type adminServer struct {
 	pb.UnimplementedTranslatorAdminServer
+	adminUsecase usecase.AdminUsecase
+}
+
+func NewTranslatorAdminServer(adminUsecase usecase.AdminUsecase) pb.TranslatorAdminServer {
+	return &adminServer{
+		adminUsecase: adminUsecase,
+	}
+}
+
+func (s *adminServer) FindTranslationsByFirstLetter(ctx context.Context, in *pb.TranslationFindParameter) (*pb.TranslationFindResposne, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if len(in.Letter) != 1 {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.adminUsecase.FindTranslationsByFirstLetter(ctx, lang2, in.Letter)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationFindResposne{
+		Results: s.toTranslationResponses(results),
+	}, nil
+}
+
+func (s *adminServer) FindTranslationByTextAndPos(ctx context.Context, in *pb.TranslationFindByTextAndPosParameter) (*pb.TranslationResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	result, err := s.adminUsecase.FindTranslationByTextAndPos(ctx, lang2, in.Text, pos)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return s.toTranslationResponse(result), nil
+}
+
+func (s *adminServer) FindTranslationsByText(ctx context.Context, in *pb.TranslationFindByTextParameter) (*pb.TranslationFindResposne, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.adminUsecase.FindTranslationByText(ctx, lang2, in.Text)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationFindResposne{
+		Results: s.toTranslationResponses(results),
+	}, nil
+}
+
+func (s *adminServer) AddTranslation(ctx context.Context, in *pb.TranslationAddParameter) (*pb.TranslationAddResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	var param service.TranslationAddParameter
+	if len(in.Senses) > 0 {
+		param, err = service.NewTransalationAddParameterWithAnnotation(in.Text, pos, lang2, toSenses(in.Senses), toAnnotation(in.Note, in.Labels, in.Tags, in.Level))
+	} else {
+		param, err = service.NewTransalationAddParameterWithAnnotation(in.Text, pos, lang2, []domain.Sense{{Gloss: in.Translated}}, toAnnotation(in.Note, in.Labels, in.Tags, in.Level))
+	}
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if err := s.adminUsecase.AddTranslation(ctx, param); err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationAddResponse{}, nil
+}
+
+func (s *adminServer) UpdateTranslation(ctx context.Context, in *pb.TranslationUpdateParameter) (*pb.TranslationAddResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	var param service.TranslationUpdateParameter
+	if len(in.Senses) > 0 {
+		param, err = service.NewTransaltionUpdateParameterWithAnnotationUpdate(toSenses(in.Senses), toAnnotationUpdate(in), int(in.Version))
+	} else {
+		param, err = service.NewTransaltionUpdateParameterWithAnnotationUpdate([]domain.Sense{{Gloss: in.Translated}}, toAnnotationUpdate(in), int(in.Version))
+	}
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if err := s.adminUsecase.UpdateTranslation(ctx, lang2, in.Text, pos, param); err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationAddResponse{}, nil
+}
+
+func (s *adminServer) RemoveTranslation(ctx context.Context, in *pb.TranslationRemoveParameter) (*pb.TranslationRemoveResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if in.Version < 0 {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if err := s.adminUsecase.RemoveTranslation(ctx, lang2, in.Text, pos, int(in.Version)); err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationRemoveResponse{}, nil
+}
+
+func (s *adminServer) RestoreTranslation(ctx context.Context, in *pb.TranslationRestoreParameter) (*pb.TranslationRestoreResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if in.Version < 0 {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if err := s.adminUsecase.RestoreTranslation(ctx, lang2, in.Text, pos, int(in.Version)); err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationRestoreResponse{}, nil
+}
+
+func (s *adminServer) FindDisabledTranslations(ctx context.Context, in *pb.TranslationFindDisabledParameter) (*pb.TranslationFindResposne, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.adminUsecase.FindDisabledTranslations(ctx, lang2)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationFindResposne{Results: s.toTranslationResponses(results)}, nil
+}
+
+func (s *adminServer) SearchTranslations(ctx context.Context, in *pb.TranslationSearchParameter) (*pb.TranslationSearchResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	result, err := s.adminUsecase.SearchTranslations(ctx, lang2, &service.CustomTranslationSearchCondition{
+		Tag:      strings.ToLower(in.Tag),
+		Level:    strings.ToUpper(in.Level),
+		PageNo:   int(in.PageNo),
+		PageSize: int(in.PageSize),
+	})
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationSearchResponse{
+		TotalCount: result.TotalCount,
+		Results:    s.toTranslationResponses(result.Results),
+	}, nil
+}
+
+func (s *adminServer) FindTranslationHistory(ctx context.Context, in *pb.TranslationHistoryFindParameter) (*pb.TranslationHistoryFindResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.adminUsecase.FindTranslationHistory(ctx, lang2, in.Text, pos)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	histories := make([]*pb.TranslationHistoryResponse, len(results))
+	for i, h := range results {
+		histories[i] = &pb.TranslationHistoryResponse{
+			Version:       int32(h.Version),
+			Action:        h.Action,
+			Actor:         h.Actor,
+			OldTranslated: h.OldTranslated,
+			NewTranslated: h.NewTranslated,
+			NewSenses:     toSenseResponses(h.NewSenses),
+			CreatedAt:     timestamppb.New(h.CreatedAt),
+		}
+	}
+
+	return &pb.TranslationHistoryFindResponse{Results: histories}, nil
+}
+
+func (s *adminServer) RevertTranslation(ctx context.Context, in *pb.TranslationRevertParameter) (*pb.TranslationRevertResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	pos, err := domain.NewWordPos(int(in.Pos))
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if in.ToVersion <= 0 || in.Version < 0 {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if err := s.adminUsecase.RevertTranslation(ctx, lang2, in.Text, pos, int(in.ToVersion), int(in.Version)); err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.TranslationRevertResponse{}, nil
+}
+
+func (s *adminServer) ImportTranslations(stream pb.TranslatorAdmin_ImportTranslationsServer) error {
+	ctx := stream.Context()
+
+	dryRun := false
+	rows := make([]usecase.TranslationImportRow, 0)
+	for i := 0; ; i++ {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			dryRun = in.DryRun
+		}
+		for _, row := range in.Rows {
+			rows = append(rows, usecase.TranslationImportRow{
+				Lang2:      row.Lang2,
+				Text:       row.Text,
+				Pos:        int(row.Pos),
+				Translated: row.Translated,
+			})
+		}
+	}
+
+	results, err := s.adminUsecase.ImportTranslations(ctx, rows, dryRun)
+	if err != nil {
+		return s.errorHandle(ctx, err)
+	}
+
+	response := s.toTranslationImportResponse(results)
+	if !results.DryRun && !results.Committed {
+		st, err := status.New(codes.InvalidArgument, "invalid rows").WithDetails(response)
+		if err != nil {
+			return s.errorHandle(ctx, err)
+		}
+		return st.Err()
+	}
+
+	return stream.SendAndClose(response)
+}
+
+func (s *adminServer) FindNegativeCaches(ctx context.Context, in *pb.NegativeCacheFindParameter) (*pb.NegativeCacheFindResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	result, err := s.adminUsecase.FindNegativeCaches(ctx, lang2, &service.TranslationSearchCondition{
+		PageNo:   int(in.PageNo),
+		PageSize: int(in.PageSize),
+	})
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	results := make([]*pb.NegativeCacheResponse, len(result.Results))
+	for i, r := range result.Results {
+		results[i] = &pb.NegativeCacheResponse{
+			Lang2:     r.Lang2.String(),
+			Text:      r.Text,
+			ExpiresAt: timestamppb.New(r.ExpiresAt),
+		}
+	}
+
+	return &pb.NegativeCacheFindResponse{
+		TotalCount: result.TotalCount,
+		Results:    results,
+	}, nil
+}
+
+func (s *adminServer) RemoveNegativeCaches(ctx context.Context, in *pb.NegativeCacheRemoveParameter) (*pb.NegativeCacheRemoveResponse, error) {
+	lang2, err := domain.NewLang2(in.Lang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	removed, err := s.adminUsecase.RemoveNegativeCaches(ctx, lang2, in.Text)
+	if err != nil {
+		return nil, s.errorHandle(ctx, err)
+	}
+
+	return &pb.NegativeCacheRemoveResponse{
+		Removed: removed,
+	}, nil
+}
+
+func (s *adminServer) toTranslationImportResponse(results *usecase.TranslationImportResults) *pb.TranslationImportResponse {
+	importResults := make([]*pb.TranslationImportResult, len(results.Results))
+	for i, r := range results.Results {
+		importResults[i] = &pb.TranslationImportResult{
+			RowNo:   int32(r.RowNo),
+			Lang2:   r.Lang2,
+			Text:    r.Text,
+			Pos:     int32(r.Pos),
+			Status:  string(r.Status),
+			Message: r.Message,
+		}
+	}
+
+	return &pb.TranslationImportResponse{
+		DryRun:    results.DryRun,
+		Committed: results.Committed,
+		Results:   importResults,
+	}
+}
+
+func (s *adminServer) toTranslationResponse(t domain.Translation) *pb.TranslationResponse {
+	version := 0
+	if t.GetProvider() == service.TranslationProviderCustom {
+		version = t.GetVersion()
+	}
+
+	return &pb.TranslationResponse{
+		Lang2:            t.GetLang2().String(),
+		Text:             t.GetText(),
+		Pos:              int32(t.GetPos()),
+		Translated:       t.GetTranslated(),
+		Provider:         t.GetProvider(),
+		Confidence:       t.GetConfidence(),
+		NormalizedSource: t.GetNormalizedSource(),
+		PrefixWord:       t.GetPrefixWord(),
+		BackTranslations: toBackTranslationResponses(t.GetBackTranslations()),
+		Senses:           toSenseResponses(t.GetSenses()),
+		Version:          int32(version),
+		Disabled:         t.IsDisabled(),
+		Note:             t.GetAnnotation().Note,
+		Labels:           t.GetAnnotation().Labels,
+		Tags:             t.GetAnnotation().Tags,
+		Level:            t.GetAnnotation().Level,
+	}
+}
+
+func toAnnotation(note string, labels, tags []string, level string) domain.TranslationAnnotation {
+	return domain.TranslationAnnotation{
+		Note:   note,
+		Labels: labels,
+		Tags:   tags,
+		Level:  level,
+	}
+}
+
+// toAnnotationUpdate returns the update of the fields which are set
+func toAnnotationUpdate(in *pb.TranslationUpdateParameter) service.TranslationAnnotationUpdate {
+	update := service.TranslationAnnotationUpdate{
+		Note:  in.Note,
+		Level: in.Level,
+	}
+	if in.Labels != nil {
+		update.Labels = &in.Labels.Values
+	}
+	if in.Tags != nil {
+		update.Tags = &in.Tags.Values
+	}
+	return update
+}
+
+func toSenses(senses []*pb.Sense) []domain.Sense {
+	results := make([]domain.Sense, len(senses))
+	for i, s := range senses {
+		results[i] = domain.Sense{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: int(s.Order),
+		}
+	}
+	return results
+}
+
+func (s *adminServer) toTranslationResponses(translations []domain.Translation) []*pb.TranslationResponse {
+	results := make([]*pb.TranslationResponse, len(translations))
+	for i, t := range translations {
+		results[i] = s.toTranslationResponse(t)
+	}
+	return results
+}
+
+func (s *adminServer) errorHandle(ctx context.Context, err error) error {
+	logger := log.FromContext(ctx)
+
+	if errors.Is(err, service.ErrTranslationNotFound) {
+		logger.Warnf("adminServer. err: %v", err)
+		return status.New(codes.NotFound, "translation not found").Err()
+	}
+	if errors.Is(err, service.ErrTranslationAlreadyExists) {
+		logger.Warnf("adminServer. err: %v", err)
+		return status.New(codes.AlreadyExists, "translation already exists").Err()
+	}
+	if errors.Is(err, service.ErrVersionConflict) {
+		logger.Warnf("adminServer. err: %v", err)
+		return status.New(codes.Aborted, "translation has been modified").Err()
+	}
+	if errors.Is(err, libD.ErrInvalidArgument) {
+		logger.Warnf("adminServer. err: %v", err)
+		return status.New(codes.InvalidArgument, "bad request").Err()
+	}
+	logger.Errorf("adminServer. err: %v", err)
+	return status.New(codes.Internal, "internal error").Err()
 }
@@ -0,0 +1,242 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/controller"
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	usecase_mock "github.com/kujilabo/cocotola-translator-api/src/app/usecase/mock"
+	pb "github.com/kujilabo/cocotola-translator-api/src/proto"
+)
+
+func Test_adminServer_FindTranslationsByText_OK(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", "custom")
+	require.NoError(t, err)
+	adminUsecase.On("FindTranslationByText", anythingOfContext, domain.Lang2JA, "book").Return([]domain.Translation{book}, nil)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	resp, err := s.FindTranslationsByText(bg, &pb.TranslationFindByTextParameter{Lang2: "ja", Text: "book"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Results))
+	assert.Equal(t, "本", resp.Results[0].Translated)
+	assert.Equal(t, "custom", resp.Results[0].Provider)
+}
+
+func Test_adminServer_FindTranslationByTextAndPos_NotFound(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("FindTranslationByTextAndPos", anythingOfContext, domain.Lang2JA, "book", domain.PosVerb).Return(nil, service.ErrTranslationNotFound)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err := s.FindTranslationByTextAndPos(bg, &pb.TranslationFindByTextAndPosParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosVerb)})
+
+	// then
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func Test_adminServer_AddTranslation_AlreadyExists(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	param, err := service.NewTransalationAddParameter("book", domain.PosNoun, domain.Lang2JA, "本")
+	require.NoError(t, err)
+	adminUsecase.On("AddTranslation", anythingOfContext, param).Return(service.ErrTranslationAlreadyExists)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err = s.AddTranslation(bg, &pb.TranslationAddParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), Translated: "本"})
+
+	// then
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func Test_adminServer_AddTranslation_EmptyTranslated(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err := s.AddTranslation(bg, &pb.TranslationAddParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), Translated: ""})
+
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	adminUsecase.AssertNotCalled(t, "AddTranslation", mock.Anything, mock.Anything)
+}
+
+func Test_adminServer_RemoveTranslation_InvalidPos(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err := s.RemoveTranslation(bg, &pb.TranslationRemoveParameter{Lang2: "ja", Text: "book", Pos: 50})
+
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_adminServer_UpdateTranslation_VersionConflict(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	param, err := service.NewTransaltionUpdateParameter("本", 2)
+	require.NoError(t, err)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, param).Return(service.ErrVersionConflict)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err = s.UpdateTranslation(bg, &pb.TranslationUpdateParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), Translated: "本", Version: 2})
+
+	// then
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func Test_adminServer_RemoveTranslation_VersionConflict(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RemoveTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 2).Return(service.ErrVersionConflict)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	_, err := s.RemoveTranslation(bg, &pb.TranslationRemoveParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), Version: 2})
+
+	// then
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func Test_adminServer_RevertTranslation(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RevertTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 1, 0).Return(nil)
+	adminUsecase.On("RevertTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 1, 3).Return(service.ErrVersionConflict)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	tests := []struct {
+		name      string
+		toVersion int32
+		version   int32
+		wantCode  codes.Code
+	}{
+		{name: "revert", toVersion: 1, version: 0, wantCode: codes.OK},
+		{name: "version conflict", toVersion: 1, version: 3, wantCode: codes.Aborted},
+		{name: "no toVersion", toVersion: 0, version: 0, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := s.RevertTranslation(bg, &pb.TranslationRevertParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), ToVersion: tt.toVersion, Version: tt.version})
+
+			// then
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_adminServer_RestoreTranslation(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("RestoreTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, 2).Return(nil)
+	adminUsecase.On("RestoreTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosVerb, 0).Return(service.ErrTranslationNotFound)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	tests := []struct {
+		name     string
+		pos      domain.WordPos
+		version  int32
+		wantCode codes.Code
+	}{
+		{name: "disabled", pos: domain.PosNoun, version: 2, wantCode: codes.OK},
+		{name: "not disabled", pos: domain.PosVerb, version: 0, wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := s.RestoreTranslation(bg, &pb.TranslationRestoreParameter{Lang2: "ja", Text: "book", Pos: int32(tt.pos), Version: tt.version})
+
+			// then
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_adminServer_SearchTranslations(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	book, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Annotation: domain.TranslationAnnotation{Labels: []string{"formal"}, Tags: []string{"business"}, Level: "A1"},
+	})
+	require.NoError(t, err)
+	adminUsecase.On("SearchTranslations", anythingOfContext, domain.Lang2JA, &service.CustomTranslationSearchCondition{Tag: "business", Level: "A1", PageNo: 1, PageSize: 10}).Return(&service.CustomTranslationSearchResult{
+		TotalCount: 1,
+		Results:    []domain.Translation{book},
+	}, nil)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+
+	// when
+	actual, err := s.SearchTranslations(bg, &pb.TranslationSearchParameter{Lang2: "ja", Tag: "Business", Level: "a1", PageNo: 1, PageSize: 10})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), actual.TotalCount)
+	require.Len(t, actual.Results, 1)
+	assert.Equal(t, []string{"formal"}, actual.Results[0].Labels)
+	assert.Equal(t, []string{"business"}, actual.Results[0].Tags)
+	assert.Equal(t, "A1", actual.Results[0].Level)
+}
+
+func Test_adminServer_UpdateTranslation_Annotation(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	adminUsecase := new(usecase_mock.AdminUsecase)
+	adminUsecase.On("UpdateTranslation", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, mock.Anything).Return(nil)
+	s := controller.NewTranslatorAdminServer(adminUsecase)
+	note := "checked"
+
+	// when
+	// - the note is set, the labels are empty, and the tags and the level are not set
+	_, err := s.UpdateTranslation(bg, &pb.TranslationUpdateParameter{Lang2: "ja", Text: "book", Pos: int32(domain.PosNoun), Translated: "本", Note: &note, Labels: &pb.StringList{}})
+
+	// then
+	// - the tags and the level are kept
+	require.NoError(t, err)
+	param := adminUsecase.Calls[0].Arguments.Get(4).(service.TranslationUpdateParameter)
+	current := domain.TranslationAnnotation{Note: "old", Labels: []string{"formal"}, Tags: []string{"business"}, Level: "N5"}
+	assert.Equal(t, domain.TranslationAnnotation{Note: "checked", Labels: []string{}, Tags: []string{"business"}, Level: "N5"}, param.GetAnnotationUpdate().Apply(current))
+}
@@ -43,6 +43,7 @@ func NewRouter(adminUsecase usecase.AdminUsecase, userUsecase usecase.UserUsecas
 		v1.Use(otelgin.Middleware(appConfig.Name))
 		v1.Use(middleware.NewTraceLogMiddleware(appConfig.Name))
 		v1.Use(authMiddleware)
+		v1.Use(newActorMiddleware())
 		{
 			admin := v1.Group("admin")
 			adminHandler := NewAdminHandler(adminUsecase)
@@ -51,13 +52,64 @@ func NewRouter(adminUsecase usecase.AdminUsecase, userUsecase usecase.UserUsecas
 			admin.GET("text/:text", adminHandler.FindTranslationsByText)
 			admin.PUT("text/:text/pos/:pos", adminHandler.UpdateTranslation)
 			admin.DELETE("text/:text/pos/:pos", adminHandler.RemoveTranslation)
+			admin.GET("text/:text/pos/:pos/history", adminHandler.FindTranslationHistory)
+			admin.POST("text/:text/pos/:pos/revert", adminHandler.RevertTranslation)
+			admin.POST("text/:text/pos/:pos/restore", adminHandler.RestoreTranslation)
+			admin.GET("disabled", adminHandler.FindDisabledTranslations)
+			admin.POST("search", adminHandler.SearchTranslations)
 			admin.POST("", adminHandler.AddTranslation)
 			admin.POST("export", adminHandler.ExportTranslations)
+			admin.POST("import", adminHandler.ImportTranslations)
+			admin.POST("negative/find", adminHandler.FindNegativeCaches)
+			admin.DELETE("negative", adminHandler.RemoveNegativeCaches)
 		}
 		{
 			user := v1.Group("user")
 			userHandler := NewUserHandler(userUsecase)
 			user.GET("dictionary/lookup", userHandler.DictionaryLookup)
+			user.POST("dictionary/lookup", userHandler.DictionaryLookupBatch)
+			user.GET("dictionary/reverse", userHandler.DictionaryReverseLookup)
+			user.GET("dictionary/examples", userHandler.DictionaryExamples)
+			user.POST("translate", userHandler.Translate)
+		}
+	}
+
+	// v2 has the languages in the paths. v1 is the same as v2 of english to japanese.
+	v2 := router.Group("v2")
+	{
+		v2.Use(otelgin.Middleware(appConfig.Name))
+		v2.Use(middleware.NewTraceLogMiddleware(appConfig.Name))
+		v2.Use(authMiddleware)
+		v2.Use(newActorMiddleware())
+		{
+			admin := v2.Group("admin")
+			adminHandler := NewAdminHandler(adminUsecase)
+			lang := admin.Group("lang/:" + lang2Param)
+			lang.POST("find", adminHandler.FindTranslationsByFirstLetter)
+			lang.GET("text/:text/pos/:pos", adminHandler.FindTranslationByTextAndPos)
+			lang.GET("text/:text", adminHandler.FindTranslationsByText)
+			lang.PUT("text/:text/pos/:pos", adminHandler.UpdateTranslation)
+			lang.DELETE("text/:text/pos/:pos", adminHandler.RemoveTranslation)
+			lang.GET("text/:text/pos/:pos/history", adminHandler.FindTranslationHistory)
+			lang.POST("text/:text/pos/:pos/revert", adminHandler.RevertTranslation)
+			lang.POST("text/:text/pos/:pos/restore", adminHandler.RestoreTranslation)
+			lang.GET("disabled", adminHandler.FindDisabledTranslations)
+			lang.POST("search", adminHandler.SearchTranslations)
+			lang.POST("", adminHandler.AddTranslation)
+			admin.POST("export", adminHandler.ExportTranslations)
+			admin.POST("import", adminHandler.ImportTranslations)
+			admin.POST("negative/find", adminHandler.FindNegativeCaches)
+			admin.DELETE("negative", adminHandler.RemoveNegativeCaches)
+		}
+		{
+			user := v2.Group("user")
+			userHandler := NewUserHandler(userUsecase)
+			dictionary := user.Group("dictionary/:" + fromLangParam + "/:" + toLangParam)
+			dictionary.GET("lookup", userHandler.DictionaryLookup)
+			dictionary.POST("lookup", userHandler.DictionaryLookupBatch)
+			dictionary.GET("reverse", userHandler.DictionaryReverseLookup)
+			dictionary.GET("examples", userHandler.DictionaryExamples)
+			user.POST("translate", userHandler.Translate)
 		}
 	}
 
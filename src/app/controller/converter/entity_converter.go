@@ -6,20 +6,114 @@ import (
 	"github.com/kujilabo/cocotola-translator-api/src/app/controller/entity"
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
 	libD "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
 )
 
+func toTranslationHTTPEntity(t domain.Translation) entity.TranslationHTTPEntity {
+	backTranslations := make([]entity.BackTranslationHTTPEntity, len(t.GetBackTranslations()))
+	for i, b := range t.GetBackTranslations() {
+		backTranslations[i] = entity.BackTranslationHTTPEntity{
+			Text:           b.Text,
+			FrequencyCount: b.FrequencyCount,
+		}
+	}
+
+	version := 0
+	if t.GetProvider() == service.TranslationProviderCustom {
+		version = t.GetVersion()
+	}
+
+	return entity.TranslationHTTPEntity{
+		Lang2:            t.GetLang2().String(),
+		Text:             t.GetText(),
+		Pos:              int(t.GetPos()),
+		Translated:       t.GetTranslated(),
+		Provider:         t.GetProvider(),
+		Confidence:       t.GetConfidence(),
+		NormalizedSource: t.GetNormalizedSource(),
+		PrefixWord:       t.GetPrefixWord(),
+		BackTranslations: backTranslations,
+		Version:          version,
+		Disabled:         t.IsDisabled(),
+		Senses:           toSenseHTTPEntities(t.GetSenses()),
+		Labels:           nilIfEmpty(t.GetAnnotation().Labels),
+		Tags:             nilIfEmpty(t.GetAnnotation().Tags),
+		Level:            t.GetAnnotation().Level,
+	}
+}
+
+// toAdminTranslationHTTPEntity returns the entity with the editor note, which is not shown to learners
+func toAdminTranslationHTTPEntity(t domain.Translation) entity.TranslationHTTPEntity {
+	e := toTranslationHTTPEntity(t)
+	e.Note = t.GetAnnotation().Note
+	return e
+}
+
+func nilIfEmpty(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func toAnnotation(note string, labels, tags []string, level string) domain.TranslationAnnotation {
+	return domain.TranslationAnnotation{
+		Note:   note,
+		Labels: labels,
+		Tags:   tags,
+		Level:  level,
+	}
+}
+
+// toAnnotationUpdate returns the update of the specified fields. Labels and tags are not specified if they are nil, and specified if they are empty.
+func toAnnotationUpdate(note *string, labels, tags []string, level *string) service.TranslationAnnotationUpdate {
+	update := service.TranslationAnnotationUpdate{
+		Note:  note,
+		Level: level,
+	}
+	if labels != nil {
+		update.Labels = &labels
+	}
+	if tags != nil {
+		update.Tags = &tags
+	}
+	return update
+}
+
+func toSenseHTTPEntities(senses []domain.Sense) []entity.SenseHTTPEntity {
+	if len(senses) == 0 {
+		return nil
+	}
+
+	results := make([]entity.SenseHTTPEntity, len(senses))
+	for i, s := range senses {
+		results[i] = entity.SenseHTTPEntity{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: s.Order,
+		}
+	}
+	return results
+}
+
+func toSenses(senses []entity.SenseHTTPEntity) []domain.Sense {
+	results := make([]domain.Sense, len(senses))
+	for i, s := range senses {
+		results[i] = domain.Sense{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: s.Order,
+		}
+	}
+	return results
+}
+
 func ToTranslationFindResposne(ctx context.Context, translations []domain.Translation) (*entity.TranslationFindResponseHTTPEntity, error) {
 
 	results := make([]entity.TranslationHTTPEntity, len(translations))
 	for i, t := range translations {
-		results[i] = entity.TranslationHTTPEntity{
-			Lang2:      t.GetLang2().String(),
-			Text:       t.GetText(),
-			Pos:        int(t.GetPos()),
-			Translated: t.GetTranslated(),
-			Provider:   t.GetProvider(),
-		}
+		results[i] = toTranslationHTTPEntity(t)
 	}
 
 	e := &entity.TranslationFindResponseHTTPEntity{
@@ -28,17 +122,108 @@ func ToTranslationFindResposne(ctx context.Context, translations []domain.Transl
 	return e, libD.Validator.Struct(e)
 }
 
-func ToTranslationResposne(context context.Context, translation domain.Translation) (*entity.TranslationHTTPEntity, error) {
-	e := &entity.TranslationHTTPEntity{
-		Lang2:      translation.GetLang2().String(),
+func ToAdminTranslationFindResposne(ctx context.Context, translations []domain.Translation) (*entity.TranslationFindResponseHTTPEntity, error) {
+	results := make([]entity.TranslationHTTPEntity, len(translations))
+	for i, t := range translations {
+		results[i] = toAdminTranslationHTTPEntity(t)
+	}
+
+	e := &entity.TranslationFindResponseHTTPEntity{
+		Results: results,
+	}
+	return e, libD.Validator.Struct(e)
+}
+
+func ToTranslationSearchResponse(ctx context.Context, result *service.CustomTranslationSearchResult) (*entity.TranslationSearchResponseHTTPEntity, error) {
+	results := make([]entity.TranslationHTTPEntity, len(result.Results))
+	for i, t := range result.Results {
+		results[i] = toAdminTranslationHTTPEntity(t)
+	}
+
+	e := &entity.TranslationSearchResponseHTTPEntity{
+		TotalCount: result.TotalCount,
+		Results:    results,
+	}
+	return e, libD.Validator.Struct(e)
+}
+
+func ToTranslationCandidateFindResposne(ctx context.Context, candidates []domain.TranslationCandidate) (*entity.TranslationFindResponseHTTPEntity, error) {
+	results := make([]entity.TranslationHTTPEntity, len(candidates))
+	for i, t := range candidates {
+		results[i] = toTranslationHTTPEntity(t)
+		results[i].Rank = t.GetRank()
+	}
+
+	e := &entity.TranslationFindResponseHTTPEntity{
+		Results: results,
+	}
+	return e, libD.Validator.Struct(e)
+}
+
+func ToDictionaryLookupBatchResponse(ctx context.Context, translations map[string][]domain.Translation) (*entity.DictionaryLookupBatchResponseHTTPEntity, error) {
+	results := make(map[string][]entity.TranslationHTTPEntity)
+	for text, ts := range translations {
+		response, err := ToTranslationFindResposne(ctx, ts)
+		if err != nil {
+			return nil, err
+		}
+		results[text] = response.Results
+	}
+
+	e := &entity.DictionaryLookupBatchResponseHTTPEntity{
+		Results: results,
+	}
+	return e, libD.Validator.Struct(e)
+}
+
+func ToDictionaryExamplesResponse(ctx context.Context, lang2 domain.Lang2, text, translated string, examples []domain.Example) (*entity.DictionaryExamplesResponseHTTPEntity, error) {
+	toSentence := func(s domain.ExampleSentence) entity.ExampleSentenceHTTPEntity {
+		return entity.ExampleSentenceHTTPEntity{
+			Prefix: s.Prefix,
+			Term:   s.Term,
+			Suffix: s.Suffix,
+		}
+	}
+
+	results := make([]entity.ExampleHTTPEntity, len(examples))
+	for i, e := range examples {
+		results[i] = entity.ExampleHTTPEntity{
+			Source: toSentence(e.GetSource()),
+			Target: toSentence(e.GetTarget()),
+		}
+	}
+
+	e := &entity.DictionaryExamplesResponseHTTPEntity{
+		Lang2:      lang2.String(),
+		Text:       text,
+		Translated: translated,
+		Results:    results,
+	}
+	return e, libD.Validator.Struct(e)
+}
+
+func ToTranslateResponse(ctx context.Context, translation domain.TextTranslation) (*entity.TranslateResponseHTTPEntity, error) {
+	e := &entity.TranslateResponseHTTPEntity{
 		Text:       translation.GetText(),
-		Pos:        int(translation.GetPos()),
+		From:       translation.GetFromLang().String(),
+		To:         translation.GetToLang().String(),
 		Translated: translation.GetTranslated(),
-		Provider:   translation.GetProvider(),
+	}
+	if translation.IsDetected() {
+		e.DetectedLanguage = &entity.DetectedLanguageHTTPEntity{
+			Language: translation.GetFromLang().String(),
+			Score:    translation.GetDetectedLangScore(),
+		}
 	}
 	return e, libD.Validator.Struct(e)
 }
 
+// ToTranslationResposne is used in the admin APIs so that the entity has the editor note
+func ToTranslationResposne(context context.Context, translation domain.Translation) (*entity.TranslationHTTPEntity, error) {
+	e := toAdminTranslationHTTPEntity(translation)
+	return &e, libD.Validator.Struct(e)
+}
+
 func ToTranslationAddParameter(ctx context.Context, param *entity.TranslationAddParameterHTTPEntity) (service.TranslationAddParameter, error) {
 	pos, err := domain.NewWordPos(param.Pos)
 	if err != nil {
@@ -49,9 +234,73 @@ func ToTranslationAddParameter(ctx context.Context, param *entity.TranslationAdd
 	if err != nil {
 		return nil, err
 	}
-	return service.NewTransalationAddParameter(param.Text, pos, lang2, param.Translated)
+
+	senses := []domain.Sense{{Gloss: param.Translated}}
+	if len(param.Senses) > 0 {
+		senses = toSenses(param.Senses)
+	}
+	return service.NewTransalationAddParameterWithAnnotation(param.Text, pos, lang2, senses, toAnnotation(param.Note, param.Labels, param.Tags, param.Level))
 }
 
-func ToTranslationUpdateParameter(ctx context.Context, param *entity.TranslationUpdateParameterHTTPEntity) (service.TranslationUpdateParameter, error) {
-	return service.NewTransaltionUpdateParameter(param.Translated)
+func ToTranslationUpdateParameter(ctx context.Context, param *entity.TranslationUpdateParameterHTTPEntity, version int) (service.TranslationUpdateParameter, error) {
+	senses := []domain.Sense{{Gloss: param.Translated}}
+	if len(param.Senses) > 0 {
+		senses = toSenses(param.Senses)
+	}
+	return service.NewTransaltionUpdateParameterWithAnnotationUpdate(senses, toAnnotationUpdate(param.Note, param.Labels, param.Tags, param.Level), version)
+}
+
+func ToTranslationHistoryResponse(ctx context.Context, histories []service.CustomTranslationHistory) *entity.TranslationHistoryResponseHTTPEntity {
+	results := make([]entity.TranslationHistoryHTTPEntity, len(histories))
+	for i, h := range histories {
+		results[i] = entity.TranslationHistoryHTTPEntity{
+			Version:       h.Version,
+			Action:        h.Action,
+			Actor:         h.Actor,
+			OldTranslated: h.OldTranslated,
+			NewTranslated: h.NewTranslated,
+			NewSenses:     toSenseHTTPEntities(h.NewSenses),
+			CreatedAt:     h.CreatedAt,
+		}
+	}
+
+	return &entity.TranslationHistoryResponseHTTPEntity{
+		Results: results,
+	}
+}
+
+func ToTranslationImportResponse(ctx context.Context, results *usecase.TranslationImportResults) *entity.TranslationImportResponseHTTPEntity {
+	importResults := make([]entity.TranslationImportResultHTTPEntity, len(results.Results))
+	for i, r := range results.Results {
+		importResults[i] = entity.TranslationImportResultHTTPEntity{
+			RowNo:   r.RowNo,
+			Lang2:   r.Lang2,
+			Text:    r.Text,
+			Pos:     r.Pos,
+			Status:  string(r.Status),
+			Message: r.Message,
+		}
+	}
+
+	return &entity.TranslationImportResponseHTTPEntity{
+		DryRun:    results.DryRun,
+		Committed: results.Committed,
+		Results:   importResults,
+	}
+}
+
+func ToNegativeCacheFindResponse(ctx context.Context, result *service.NegativeCacheSearchResult) *entity.NegativeCacheFindResponseHTTPEntity {
+	results := make([]entity.NegativeCacheHTTPEntity, len(result.Results))
+	for i, r := range result.Results {
+		results[i] = entity.NegativeCacheHTTPEntity{
+			Lang2:     r.Lang2.String(),
+			Text:      r.Text,
+			ExpiresAt: r.ExpiresAt,
+		}
+	}
+
+	return &entity.NegativeCacheFindResponseHTTPEntity{
+		TotalCount: result.TotalCount,
+		Results:    results,
+	}
 }
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 type TranslationFindParameterHTTPEntity struct {
 	Letter string `json:"letter"`
 }
@@ -10,19 +12,192 @@ type TranslationHTTPEntity struct {
 	Pos        int    `json:"pos"`
 	Translated string `json:"translated"`
 	Provider   string `json:"provider"`
+	// Confidence is between 0 and 1. Custom translations have 1.
+	Confidence       float64                     `json:"confidence"`
+	NormalizedSource string                      `json:"normalizedSource,omitempty"`
+	PrefixWord       string                      `json:"prefixWord,omitempty"`
+	BackTranslations []BackTranslationHTTPEntity `json:"backTranslations,omitempty"`
+	// Rank is set only in the "all" mode. It starts with 1 for each pos.
+	Rank int `json:"rank,omitempty"`
+	// Version is set only for custom translations. It is sent as the If-Match header to update or remove them.
+	Version int `json:"version,omitempty"`
+	// Disabled is true if the custom translation has been removed
+	Disabled bool `json:"disabled,omitempty"`
+	// Senses are set only for custom translations. Translated is the glosses of them joined with commas.
+	Senses []SenseHTTPEntity `json:"senses,omitempty"`
+	// Note is the editor note of the custom translation. It is set only in the admin APIs.
+	Note   string   `json:"note,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Level  string   `json:"level,omitempty"`
+}
+
+type SenseHTTPEntity struct {
+	Gloss string `json:"gloss" binding:"required,max=100"`
+	Note  string `json:"note,omitempty" binding:"max=200"`
+	// Order is the display order. Senses with the same order are displayed in the order of the list.
+	Order int `json:"order" binding:"gte=0"`
+}
+
+type BackTranslationHTTPEntity struct {
+	Text           string `json:"text"`
+	FrequencyCount int    `json:"frequencyCount"`
 }
 
 type TranslationFindResponseHTTPEntity struct {
 	Results []TranslationHTTPEntity `json:"results"`
 }
 
+type ExampleSentenceHTTPEntity struct {
+	Prefix string `json:"prefix"`
+	Term   string `json:"term"`
+	Suffix string `json:"suffix"`
+}
+
+type ExampleHTTPEntity struct {
+	Source ExampleSentenceHTTPEntity `json:"source"`
+	Target ExampleSentenceHTTPEntity `json:"target"`
+}
+
+type DictionaryExamplesResponseHTTPEntity struct {
+	Lang2      string              `json:"lang2"`
+	Text       string              `json:"text"`
+	Translated string              `json:"translated"`
+	Results    []ExampleHTTPEntity `json:"results"`
+}
+
+type TranslateParameterHTTPEntity struct {
+	Text string `json:"text" binding:"required,max=5000"`
+	// From is detected if it is empty
+	From string `json:"from" binding:"omitempty,len=2"`
+	// To is ja if it is empty
+	To string `json:"to" binding:"omitempty,len=2"`
+}
+
+type DetectedLanguageHTTPEntity struct {
+	Language string  `json:"language"`
+	Score    float64 `json:"score"`
+}
+
+type TranslateResponseHTTPEntity struct {
+	Text       string `json:"text"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	Translated string `json:"translated"`
+	// DetectedLanguage is set only if from is not specified
+	DetectedLanguage *DetectedLanguageHTTPEntity `json:"detectedLanguage,omitempty"`
+}
+
+type DictionaryLookupBatchParameterHTTPEntity struct {
+	Texts []string `json:"texts" binding:"required,min=1,max=1000,dive,required"`
+}
+
+type DictionaryLookupBatchResponseHTTPEntity struct {
+	// Results are keyed by text
+	Results map[string][]TranslationHTTPEntity `json:"results"`
+}
+
 type TranslationAddParameterHTTPEntity struct {
-	Lang2      string `json:"lang2" binding:"required"`
-	Text       string `json:"text" binding:"required"`
-	Pos        int    `json:"pos" binding:"required"`
-	Translated string `json:"translated" binding:"required"`
+	Lang2 string `json:"lang2" binding:"required"`
+	Text  string `json:"text" binding:"required"`
+	Pos   int    `json:"pos" binding:"required"`
+	// Translated is registered as the only sense. It is ignored if Senses is specified.
+	Translated string            `json:"translated" binding:"required_without=Senses,max=100"`
+	Senses     []SenseHTTPEntity `json:"senses" binding:"required_without=Translated,omitempty,min=1,dive"`
+	Note       string            `json:"note" binding:"max=500"`
+	// Labels are register labels such as formal, slang or archaic
+	Labels []string `json:"labels" binding:"max=10,dive,required,max=30"`
+	// Tags are domain tags such as business or medical
+	Tags []string `json:"tags" binding:"max=10,dive,required,max=30"`
+	// Level is a CEFR level (A1-C2) or a JLPT level (N5-N1)
+	Level string `json:"level" binding:"omitempty,oneof=A1 A2 B1 B2 C1 C2 N1 N2 N3 N4 N5"`
 }
 
+// TranslationUpdateParameterHTTPEntity replaces the senses and the annotation of the custom translation.
+// The current note, labels, tags and level are kept if they are omitted or null. Empty values remove them.
 type TranslationUpdateParameterHTTPEntity struct {
-	Translated string `json:"translated" binding:"required"`
+	// Translated is registered as the only sense. It is ignored if Senses is specified.
+	Translated string            `json:"translated" binding:"required_without=Senses,max=100"`
+	Senses     []SenseHTTPEntity `json:"senses" binding:"required_without=Translated,omitempty,min=1,dive"`
+	Note       *string           `json:"note" binding:"omitempty,max=500"`
+	// Labels are register labels such as formal, slang or archaic
+	Labels []string `json:"labels" binding:"max=10,dive,required,max=30"`
+	// Tags are domain tags such as business or medical
+	Tags []string `json:"tags" binding:"max=10,dive,required,max=30"`
+	// Level is a CEFR level (A1-C2) or a JLPT level (N5-N1). It is validated when it is converted because an empty level is also valid.
+	Level *string `json:"level"`
+}
+
+type TranslationSearchParameterHTTPEntity struct {
+	// Tag and Level are ignored if they are empty
+	Tag      string `json:"tag" binding:"max=30"`
+	Level    string `json:"level" binding:"omitempty,oneof=A1 A2 B1 B2 C1 C2 N1 N2 N3 N4 N5"`
+	PageNo   int    `json:"pageNo" binding:"required,gte=1"`
+	PageSize int    `json:"pageSize" binding:"required,gte=1,lte=1000"`
+}
+
+type TranslationSearchResponseHTTPEntity struct {
+	TotalCount int64                   `json:"totalCount"`
+	Results    []TranslationHTTPEntity `json:"results"`
+}
+
+type TranslationRevertParameterHTTPEntity struct {
+	// Version is the version in the history which the translation is changed back to
+	Version int `json:"version" binding:"required,gte=1"`
+}
+
+type TranslationHistoryHTTPEntity struct {
+	// Version is the version after the change
+	Version       int               `json:"version"`
+	Action        string            `json:"action"`
+	Actor         string            `json:"actor"`
+	OldTranslated string            `json:"oldTranslated,omitempty"`
+	NewTranslated string            `json:"newTranslated,omitempty"`
+	NewSenses     []SenseHTTPEntity `json:"newSenses,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+type TranslationHistoryResponseHTTPEntity struct {
+	Results []TranslationHistoryHTTPEntity `json:"results"`
+}
+
+type TranslationExportParameterHTTPEntity struct {
+	Lang2  string `json:"lang2" binding:"required,len=2"`
+	Format string `json:"format" binding:"omitempty,oneof=csv tsv"`
+}
+
+type TranslationImportResultHTTPEntity struct {
+	RowNo   int    `json:"rowNo"`
+	Lang2   string `json:"lang2"`
+	Text    string `json:"text"`
+	Pos     int    `json:"pos"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type TranslationImportResponseHTTPEntity struct {
+	DryRun    bool                                `json:"dryRun"`
+	Committed bool                                `json:"committed"`
+	Results   []TranslationImportResultHTTPEntity `json:"results"`
+}
+
+type NegativeCacheFindParameterHTTPEntity struct {
+	Lang2    string `json:"lang2" binding:"required,len=2"`
+	PageNo   int    `json:"pageNo" binding:"required,gte=1"`
+	PageSize int    `json:"pageSize" binding:"required,gte=1,lte=1000"`
+}
+
+type NegativeCacheHTTPEntity struct {
+	Lang2     string    `json:"lang2"`
+	Text      string    `json:"text"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type NegativeCacheFindResponseHTTPEntity struct {
+	TotalCount int64                     `json:"totalCount"`
+	Results    []NegativeCacheHTTPEntity `json:"results"`
+}
+
+type NegativeCacheRemoveResponseHTTPEntity struct {
+	Removed int64 `json:"removed"`
 }
@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// versionToETag returns the strong entity tag of the version such as "3"
+func versionToETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// getVersionFromIfMatch returns the version of the If-Match header. It returns 0, which means any version, if the header is empty or "*".
+func getVersionFromIfMatch(c *gin.Context) (int, error) {
+	ifMatch := strings.TrimSpace(c.GetHeader(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil || !strings.HasPrefix(ifMatch, `"`) {
+		return 0, liberrors.Errorf("invalid If-Match header. If-Match: %s", ifMatch)
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return 0, liberrors.Errorf("invalid If-Match header. If-Match: %s", ifMatch)
+	}
+	return version, nil
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/controller/helper"
+)
+
+const (
+	fromLangParam = "fromLang"
+	toLangParam   = "toLang"
+	lang2Param    = "lang2"
+)
+
+// getLang2FromPath returns the language of the path parameter. v1 paths have no language parameters and defaultLang is returned for them.
+func getLang2FromPath(c *gin.Context, param string, defaultLang domain.Lang2) (domain.Lang2, error) {
+	lang := helper.GetStringFromPath(c, param)
+	if lang == "" {
+		return defaultLang, nil
+	}
+	return domain.NewLang2(lang)
+}
+
+// getLangPairFromPath returns the languages of fromLang and toLang path parameters. They are english and japanese for v1 paths.
+func getLangPairFromPath(c *gin.Context) (domain.Lang2, domain.Lang2, error) {
+	fromLang, err := getLang2FromPath(c, fromLangParam, domain.Lang2EN)
+	if err != nil {
+		return nil, nil, err
+	}
+	toLang, err := getLang2FromPath(c, toLangParam, domain.Lang2JA)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromLang, toLang, nil
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+)
+
+// translationCSVHeader starts with the columns of translationImportCSVHeader so that the exported file can be imported as it is
+var translationCSVHeader = []string{"lang2", "text", "pos", "translated", "provider", "version", "updated_at"}
+
+type translationCSVPresenter struct {
+	writer  *csv.Writer
+	flusher http.Flusher
+}
+
+// newTranslationCSVPresenter returns a presenter which writes translations to w as CSV rows separated by comma.
+// Each batch is flushed to the client immediately, so the response is sent with chunked encoding.
+func newTranslationCSVPresenter(w http.ResponseWriter, comma rune) (usecase.AdminPresenter, error) {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+
+	p := &translationCSVPresenter{writer: writer}
+	if flusher, ok := w.(http.Flusher); ok {
+		p.flusher = flusher
+	}
+
+	if err := p.write(translationCSVHeader); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *translationCSVPresenter) WriteTranslations(ctx context.Context, translations []domain.Translation) error {
+	rows := make([][]string, len(translations))
+	for i, t := range translations {
+		rows[i] = p.toRow(t)
+	}
+	return p.write(rows...)
+}
+
+func (p *translationCSVPresenter) WriteTranslation(ctx context.Context, translation domain.Translation) error {
+	return p.write(p.toRow(translation))
+}
+
+func (p *translationCSVPresenter) toRow(t domain.Translation) []string {
+	return []string{
+		t.GetLang2().String(),
+		t.GetText(),
+		strconv.Itoa(int(t.GetPos())),
+		t.GetTranslated(),
+		t.GetProvider(),
+		strconv.Itoa(t.GetVersion()),
+		t.GetUpdatedAt().Format(time.RFC3339),
+	}
+}
+
+func (p *translationCSVPresenter) write(rows ...[]string) error {
+	for _, row := range rows {
+		if err := p.writer.Write(row); err != nil {
+			return err
+		}
+	}
+	p.writer.Flush()
+	if err := p.writer.Error(); err != nil {
+		return err
+	}
+	if p.flusher != nil {
+		p.flusher.Flush()
+	}
+	return nil
+}
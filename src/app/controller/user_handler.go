@@ -4,10 +4,12 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/controller/converter"
+	"github.com/kujilabo/cocotola-translator-api/src/app/controller/entity"
 	handlerhelper "github.com/kujilabo/cocotola-translator-api/src/app/controller/helper"
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
@@ -17,8 +19,32 @@ import (
 	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
 )
 
+const (
+	lookupModeBest = "best"
+	lookupModeAll  = "all"
+)
+
+const (
+	matchModeExact  = "exact"
+	matchModePrefix = "prefix"
+)
+
+// The lengths of the columns of azure_example
+const (
+	dictionaryExamplesMaxTextLength       = 30
+	dictionaryExamplesMaxTranslatedLength = 100
+)
+
 type UserHandler interface {
 	DictionaryLookup(c *gin.Context)
+
+	DictionaryLookupBatch(c *gin.Context)
+
+	DictionaryReverseLookup(c *gin.Context)
+
+	DictionaryExamples(c *gin.Context)
+
+	Translate(c *gin.Context)
 }
 
 type userHandler struct {
@@ -35,25 +61,45 @@ func NewUserHandler(userUsecase usecase.UserUsecase) UserHandler {
 // @Tags        translator
 // @Accept      json
 // @Produce     json
+// @Param       fromLang path string true "ISO 639-1 code of the text. v2 only"
+// @Param       toLang path string true "ISO 639-1 code of the translations. v2 only"
 // @Param       text query string true "text"
 // @Param       pos query int false "pos"
-// @Success     200 {object} entity.Translation
+// @Param       mode query string false "best(default) returns the best translation for each pos. all returns all the translations ranked for each pos" Enums(best, all)
+// @Success     200 {object} entity.TranslationFindResponseHTTPEntity
 // @Failure     400
 // @Failure     401
 // @Router      /v1/user/dictionary/lookup [get]
+// @Router      /v2/user/dictionary/{fromLang}/{toLang}/lookup [get]
 // @Security    BasicAuth
 func (h *userHandler) DictionaryLookup(c *gin.Context) {
 	ctx := c.Request.Context()
 	handlerhelper.HandleFunction(c, func() error {
+		fromLang, toLang, err := getLangPairFromPath(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		text := helper.GetStringFromQuery(c, "text")
 		if len(text) <= 1 {
 			c.Status(http.StatusBadRequest)
 			return nil
 		}
 
+		mode := helper.GetStringFromQuery(c, "mode")
+		switch mode {
+		case "", lookupModeBest:
+		case lookupModeAll:
+			return h.dictionaryLookupCandidates(c, fromLang, toLang, text, helper.GetStringFromQuery(c, "pos"))
+		default:
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
 		posS := helper.GetStringFromQuery(c, "pos")
 		if len(posS) == 0 {
-			results, err := h.userUsecase.DictionaryLookup(ctx, domain.Lang2EN, domain.Lang2JA, text)
+			results, err := h.userUsecase.DictionaryLookup(ctx, fromLang, toLang, text)
 			if err != nil {
 				return liberrors.Errorf("failed userUsecase.DictionaryLookup in userHandler.DictionaryLookup. err: %w", err)
 			}
@@ -79,7 +125,7 @@ func (h *userHandler) DictionaryLookup(c *gin.Context) {
 			return nil
 		}
 
-		result, err := h.userUsecase.DictionaryLookupWithPos(ctx, domain.Lang2EN, domain.Lang2JA, text, pos)
+		result, err := h.userUsecase.DictionaryLookupWithPos(ctx, fromLang, toLang, text, pos)
 		if err != nil {
 			return err
 		}
@@ -94,6 +140,258 @@ func (h *userHandler) DictionaryLookup(c *gin.Context) {
 	}, h.errorHandle)
 }
 
+// dictionaryLookupCandidates writes all the translations of the text. They are filtered by pos unless posS is empty.
+func (h *userHandler) dictionaryLookupCandidates(c *gin.Context, fromLang, toLang domain.Lang2, text, posS string) error {
+	ctx := c.Request.Context()
+
+	var pos domain.WordPos
+	if len(posS) != 0 {
+		posI, err := strconv.Atoi(posS)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		pos, err = domain.NewWordPos(posI)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+	}
+
+	results, err := h.userUsecase.DictionaryLookupCandidates(ctx, fromLang, toLang, text)
+	if err != nil {
+		return liberrors.Errorf("failed userUsecase.DictionaryLookupCandidates in userHandler.dictionaryLookupCandidates. err: %w", err)
+	}
+
+	if len(posS) != 0 {
+		filtered := make([]domain.TranslationCandidate, 0)
+		for _, r := range results {
+			if r.GetPos() == pos {
+				filtered = append(filtered, r)
+			}
+		}
+		results = filtered
+	}
+
+	response, err := converter.ToTranslationCandidateFindResposne(ctx, results)
+	if err != nil {
+		return err
+	}
+
+	c.JSON(http.StatusOK, response)
+	return nil
+}
+
+// DictionaryLookupBatch godoc
+// @Summary     dictionary lookup of multiple texts
+// @Description dictionary lookup of multiple texts. results are keyed by text
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       fromLang path string true "ISO 639-1 code of the texts. v2 only"
+// @Param       toLang path string true "ISO 639-1 code of the translations. v2 only"
+// @Param       param body entity.DictionaryLookupBatchParameterHTTPEntity true "parameter to look up"
+// @Success     200 {object} entity.DictionaryLookupBatchResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/user/dictionary/lookup [post]
+// @Router      /v2/user/dictionary/{fromLang}/{toLang}/lookup [post]
+// @Security    BasicAuth
+func (h *userHandler) DictionaryLookupBatch(c *gin.Context) {
+	ctx := c.Request.Context()
+	handlerhelper.HandleFunction(c, func() error {
+		fromLang, toLang, err := getLangPairFromPath(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		param := entity.DictionaryLookupBatchParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		results, err := h.userUsecase.DictionaryLookupBatch(ctx, fromLang, toLang, param.Texts)
+		if err != nil {
+			return liberrors.Errorf("failed userUsecase.DictionaryLookupBatch in userHandler.DictionaryLookupBatch. err: %w", err)
+		}
+
+		response, err := converter.ToDictionaryLookupBatchResponse(ctx, results)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// DictionaryReverseLookup godoc
+// @Summary     reverse dictionary lookup
+// @Description find the texts from their translation. the results are in confidence order
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       fromLang path string true "ISO 639-1 code of the texts. v2 only"
+// @Param       toLang path string true "ISO 639-1 code of the translation. v2 only"
+// @Param       translated query string true "translation"
+// @Param       match query string false "exact(default) finds the translations equal to translated. prefix finds the translations starting with translated" Enums(exact, prefix)
+// @Success     200 {object} entity.TranslationFindResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Router      /v1/user/dictionary/reverse [get]
+// @Router      /v2/user/dictionary/{fromLang}/{toLang}/reverse [get]
+// @Security    BasicAuth
+func (h *userHandler) DictionaryReverseLookup(c *gin.Context) {
+	ctx := c.Request.Context()
+	handlerhelper.HandleFunction(c, func() error {
+		fromLang, toLang, err := getLangPairFromPath(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		translated := helper.GetStringFromQuery(c, "translated")
+		if len(translated) == 0 {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		var prefix bool
+		switch helper.GetStringFromQuery(c, "match") {
+		case "", matchModeExact:
+		case matchModePrefix:
+			prefix = true
+		default:
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		results, err := h.userUsecase.DictionaryReverseLookup(ctx, fromLang, toLang, translated, prefix)
+		if err != nil {
+			return liberrors.Errorf("failed userUsecase.DictionaryReverseLookup in userHandler.DictionaryReverseLookup. err: %w", err)
+		}
+
+		response, err := converter.ToTranslationFindResposne(ctx, results)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// DictionaryExamples godoc
+// @Summary     example sentences
+// @Description example sentences of a text and its translation
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       fromLang path string true "ISO 639-1 code of the text. v2 only"
+// @Param       toLang path string true "ISO 639-1 code of the translation. v2 only"
+// @Param       text query string true "text"
+// @Param       translated query string true "translation of the text"
+// @Success     200 {object} entity.DictionaryExamplesResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Failure     501
+// @Router      /v1/user/dictionary/examples [get]
+// @Router      /v2/user/dictionary/{fromLang}/{toLang}/examples [get]
+// @Security    BasicAuth
+func (h *userHandler) DictionaryExamples(c *gin.Context) {
+	ctx := c.Request.Context()
+	handlerhelper.HandleFunction(c, func() error {
+		fromLang, toLang, err := getLangPairFromPath(c)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		text := helper.GetStringFromQuery(c, "text")
+		translated := helper.GetStringFromQuery(c, "translated")
+		if len(text) <= 1 || len(translated) == 0 ||
+			utf8.RuneCountInString(text) > dictionaryExamplesMaxTextLength ||
+			utf8.RuneCountInString(translated) > dictionaryExamplesMaxTranslatedLength {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		results, err := h.userUsecase.DictionaryExamples(ctx, fromLang, toLang, text, translated)
+		if err != nil {
+			return liberrors.Errorf("failed userUsecase.DictionaryExamples in userHandler.DictionaryExamples. err: %w", err)
+		}
+
+		response, err := converter.ToDictionaryExamplesResponse(ctx, toLang, text, translated, results)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
+// Translate godoc
+// @Summary     translate a text
+// @Description translate a free text such as a sentence. the language of the text is detected if from is empty
+// @Tags        translator
+// @Accept      json
+// @Produce     json
+// @Param       param body entity.TranslateParameterHTTPEntity true "parameter to translate"
+// @Success     200 {object} entity.TranslateResponseHTTPEntity
+// @Failure     400
+// @Failure     401
+// @Failure     501
+// @Router      /v1/user/translate [post]
+// @Router      /v2/user/translate [post]
+// @Security    BasicAuth
+func (h *userHandler) Translate(c *gin.Context) {
+	ctx := c.Request.Context()
+	handlerhelper.HandleFunction(c, func() error {
+		param := entity.TranslateParameterHTTPEntity{}
+		if err := c.ShouldBindJSON(&param); err != nil {
+			c.Status(http.StatusBadRequest)
+			return nil
+		}
+
+		var fromLang domain.Lang2
+		if param.From != "" {
+			lang, err := domain.NewLang2(param.From)
+			if err != nil {
+				c.Status(http.StatusBadRequest)
+				return nil
+			}
+			fromLang = lang
+		}
+
+		toLang := domain.Lang2JA
+		if param.To != "" {
+			lang, err := domain.NewLang2(param.To)
+			if err != nil {
+				c.Status(http.StatusBadRequest)
+				return nil
+			}
+			toLang = lang
+		}
+
+		result, err := h.userUsecase.Translate(ctx, fromLang, toLang, param.Text)
+		if err != nil {
+			return liberrors.Errorf("failed userUsecase.Translate in userHandler.Translate. err: %w", err)
+		}
+
+		response, err := converter.ToTranslateResponse(ctx, result)
+		if err != nil {
+			return err
+		}
+
+		c.JSON(http.StatusOK, response)
+		return nil
+	}, h.errorHandle)
+}
+
 func (h *userHandler) errorHandle(c *gin.Context, err error) bool {
 	ctx := c.Request.Context()
 	logger := log.FromContext(ctx)
@@ -102,6 +400,18 @@ func (h *userHandler) errorHandle(c *gin.Context, err error) bool {
 		logger.Warnf("userHandler. err: %v", err)
 		c.JSON(http.StatusConflict, gin.H{"message": "Translation already exists"})
 		return true
+	} else if errors.Is(err, service.ErrUnsupportedLanguagePair) {
+		logger.Warnf("userHandler. err: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"message": "Unsupported language pair"})
+		return true
+	} else if errors.Is(err, service.ErrExampleProviderNotFound) {
+		logger.Warnf("userHandler. err: %v", err)
+		c.JSON(http.StatusNotImplemented, gin.H{"message": "Examples are not available"})
+		return true
+	} else if errors.Is(err, service.ErrTextTranslatorNotFound) {
+		logger.Warnf("userHandler. err: %v", err)
+		c.JSON(http.StatusNotImplemented, gin.H{"message": "Translation of texts is not available"})
+		return true
 	}
 	logger.Errorf("userHandler. err: %+v", err)
 	return false
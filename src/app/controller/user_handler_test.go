@@ -0,0 +1,320 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/config"
+	"github.com/kujilabo/cocotola-translator-api/src/app/controller"
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+	usecase_mock "github.com/kujilabo/cocotola-translator-api/src/app/usecase/mock"
+)
+
+func initUserRouter(userUsecase usecase.UserUsecase) *gin.Engine {
+	adminUsecase := new(usecase_mock.AdminUsecase)
+
+	return controller.NewRouter(adminUsecase, userUsecase, initCrosConfig(), &config.AppConfig{Name: "app"}, &config.AuthConfig{Username: "user", Password: "pass"}, &config.DebugConfig{GinMode: false})
+}
+
+func Test_userHandler_DictionaryLookupBatch_OK(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", "azure")
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookupBatch", anythingOfContext, domain.Lang2EN, domain.Lang2JA, []string{"book", "xyz"}).Return(map[string][]domain.Translation{
+		"book": {book},
+		"xyz":  {},
+	}, nil)
+	r := initUserRouter(userUsecase)
+
+	// when
+	body, err := json.Marshal(gin.H{"texts": []string{"book", "xyz"}})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/v1/user/dictionary/lookup", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	translated := parseExpr(t, "$.results.book[*].translated").Get(jsonObj)
+	assert.Equal(t, []interface{}{"本"}, translated)
+	xyz := parseExpr(t, "$.results.xyz[*]").Get(jsonObj)
+	assert.Equal(t, 0, len(xyz))
+}
+
+func Test_userHandler_DictionaryLookupBatch_NoTexts(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	r := initUserRouter(userUsecase)
+
+	// when
+	body, err := json.Marshal(gin.H{"texts": []string{}})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, "/v1/user/dictionary/lookup", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	userUsecase.AssertNotCalled(t, "DictionaryLookupBatch")
+}
+
+func Test_userHandler_DictionaryLookup_ModeAll(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	newCandidate := func(pos domain.WordPos, translated string, confidence float64, rank int) domain.TranslationCandidate {
+		translation, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", pos, domain.Lang2JA, translated, "azure", domain.TranslationDetail{Confidence: confidence})
+		require.NoError(t, err)
+		candidate, err := domain.NewTranslationCandidate(translation, rank)
+		require.NoError(t, err)
+		return candidate
+	}
+	userUsecase.On("DictionaryLookupCandidates", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book").Return([]domain.TranslationCandidate{
+		newCandidate(domain.PosNoun, "書籍", 0.6, 1),
+		newCandidate(domain.PosNoun, "本", 0.4, 2),
+		newCandidate(domain.PosVerb, "予約する", 1, 1),
+	}, nil)
+	r := initUserRouter(userUsecase)
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, "/v1/user/dictionary/lookup?text=book&mode=all&pos=6", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - only the nouns are returned in rank order
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	translated := parseExpr(t, "$.results[*].translated").Get(jsonObj)
+	assert.Equal(t, []interface{}{"書籍", "本"}, translated)
+	rank := parseExpr(t, "$.results[*].rank").Get(jsonObj)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, rank)
+}
+
+func Test_userHandler_DictionaryLookup_InvalidMode(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	r := initUserRouter(userUsecase)
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, "/v1/user/dictionary/lookup?text=book&mode=some", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	userUsecase.AssertNotCalled(t, "DictionaryLookup")
+	userUsecase.AssertNotCalled(t, "DictionaryLookupCandidates")
+}
+
+func Test_userHandler_DictionaryLookup_V2(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	ko, err := domain.NewLang2("ko")
+	require.NoError(t, err)
+	zh, err := domain.NewLang2("zh")
+	require.NoError(t, err)
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, ko, "책", "azure")
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, ko, "book").Return([]domain.Translation{book}, nil)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2JA, zh, "本").Return(nil, service.ErrUnsupportedLanguagePair)
+	r := initUserRouter(userUsecase)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{name: "korean", path: "/v2/user/dictionary/en/ko/lookup?text=book", wantStatus: http.StatusOK},
+		{name: "unsupported pair", path: "/v2/user/dictionary/ja/zh/lookup?text=" + url.QueryEscape("本"), wantStatus: http.StatusBadRequest},
+		{name: "unknown language", path: "/v2/user/dictionary/en/xx/lookup?text=book", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodGet, tt.path, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+	userUsecase.AssertNumberOfCalls(t, "DictionaryLookup", 2)
+}
+
+func Test_userHandler_DictionaryReverseLookup(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	reservation, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "reservation", domain.PosNoun, domain.Lang2JA, "予約", "azure", domain.TranslationDetail{Confidence: 0.7})
+	require.NoError(t, err)
+	userUsecase.On("DictionaryReverseLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "予約", true).Return([]domain.Translation{reservation}, nil)
+	r := initUserRouter(userUsecase)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "prefix", query: "translated=" + url.QueryEscape("予約") + "&match=prefix", wantStatus: http.StatusOK},
+		{name: "no translated", query: "match=prefix", wantStatus: http.StatusBadRequest},
+		{name: "invalid match", query: "translated=" + url.QueryEscape("予約") + "&match=some", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodGet, "/v2/user/dictionary/en/ja/reverse?"+tt.query, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				jsonObj := parseJSON(t, w.Body)
+				text := parseExpr(t, "$.results[*].text").Get(jsonObj)
+				assert.Equal(t, []interface{}{"reservation"}, text)
+			}
+		})
+	}
+	userUsecase.AssertNumberOfCalls(t, "DictionaryReverseLookup", 1)
+}
+
+func Test_userHandler_DictionaryExamples(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	example, err := domain.NewExample(domain.Lang2JA, "book", "本",
+		domain.ExampleSentence{Prefix: "I read a ", Term: "book", Suffix: "."},
+		domain.ExampleSentence{Prefix: "私は", Term: "本", Suffix: "を読んだ。"})
+	require.NoError(t, err)
+	userUsecase.On("DictionaryExamples", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book", "本").Return([]domain.Example{example}, nil)
+	userUsecase.On("DictionaryExamples", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "run", "走る").Return(nil, service.ErrExampleProviderNotFound)
+	r := initUserRouter(userUsecase)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantTerms  []interface{}
+	}{
+		{name: "found", query: "text=book&translated=本", wantStatus: http.StatusOK, wantTerms: []interface{}{"book"}},
+		{name: "no translated", query: "text=book", wantStatus: http.StatusBadRequest},
+		{name: "too long text", query: "text=" + strings.Repeat("a", 31) + "&translated=本", wantStatus: http.StatusBadRequest},
+		{name: "too long translated", query: "text=book&translated=" + strings.Repeat("本", 101), wantStatus: http.StatusBadRequest},
+		{name: "no provider", query: "text=run&translated=走る", wantStatus: http.StatusNotImplemented},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			req, err := http.NewRequest(http.MethodGet, "/v1/user/dictionary/examples?"+tt.query, nil)
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			jsonObj := parseJSON(t, w.Body)
+			terms := parseExpr(t, "$.results[*].source.term").Get(jsonObj)
+			assert.Equal(t, tt.wantTerms, terms)
+		})
+	}
+}
+
+func Test_userHandler_Translate(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	detected, err := domain.NewTextTranslation("I read a book.", domain.Lang2EN, domain.Lang2JA, "私は本を読んだ。", true, 0.98)
+	require.NoError(t, err)
+	userUsecase.On("Translate", anythingOfContext, nil, domain.Lang2JA, "I read a book.").Return(detected, nil)
+	r := initUserRouter(userUsecase)
+
+	tests := []struct {
+		name       string
+		param      gin.H
+		wantStatus int
+	}{
+		{name: "detected", param: gin.H{"text": "I read a book."}, wantStatus: http.StatusOK},
+		{name: "no text", param: gin.H{"text": ""}, wantStatus: http.StatusBadRequest},
+		{name: "invalid from", param: gin.H{"text": "I read a book.", "from": "english"}, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			body, err := json.Marshal(tt.param)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "/v1/user/translate", bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.SetBasicAuth("user", "pass")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			jsonObj := parseJSON(t, w.Body)
+			assert.Equal(t, "私は本を読んだ。", parseExpr(t, "$.translated").Get(jsonObj)[0])
+			assert.Equal(t, "en", parseExpr(t, "$.detectedLanguage.language").Get(jsonObj)[0])
+			assert.Equal(t, 0.98, parseExpr(t, "$.detectedLanguage.score").Get(jsonObj)[0])
+		})
+	}
+}
+
+func Test_userHandler_DictionaryLookup_Annotation(t *testing.T) {
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	book, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "書物", service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Annotation: domain.TranslationAnnotation{Note: "checked by editors", Labels: []string{"formal"}, Tags: []string{"business"}, Level: "B1"},
+	})
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book").Return([]domain.Translation{book}, nil)
+	r := initUserRouter(userUsecase)
+
+	// when
+	req, err := http.NewRequest(http.MethodGet, "/v1/user/dictionary/lookup?text=book", nil)
+	require.NoError(t, err)
+	req.SetBasicAuth("user", "pass")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	// then
+	// - the labels are shown to learners but the editor note is not
+	assert.Equal(t, http.StatusOK, w.Code)
+	jsonObj := parseJSON(t, w.Body)
+	assert.Equal(t, []interface{}{"formal"}, parseExpr(t, "$.results[0].labels[*]").Get(jsonObj))
+	assert.Equal(t, []interface{}{"business"}, parseExpr(t, "$.results[0].tags[*]").Get(jsonObj))
+	assert.Equal(t, []interface{}{"B1"}, parseExpr(t, "$.results[0].level").Get(jsonObj))
+	assert.Empty(t, parseExpr(t, "$.results[0].note").Get(jsonObj))
+}
@@ -2,23 +2,38 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"io"
+	"sync"
+	"unicode/utf8"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
 	pb "github.com/kujilabo/cocotola-translator-api/src/proto"
 )
 
+// dictionaryLookupBatchMaxTexts is the same as the limit of DictionaryLookupBatchParameterHTTPEntity
+const dictionaryLookupBatchMaxTexts = 1000
+
+// translateMaxTextLength is the same as the limit of TranslateParameterHTTPEntity
+const translateMaxTextLength = 5000
+
 type userServer struct {
 	pb.UnimplementedTranslatorUserServer
-	userUsecase usecase.UserUsecase
+	userUsecase       usecase.UserUsecase
+	streamConcurrency int
 }
 
-func NewTranslatorUserServer(userUsecase usecase.UserUsecase) pb.TranslatorUserServer {
+// NewTranslatorUserServer returns the server. streamConcurrency is the maximum number of texts which DictionaryLookupStream looks up at the same time.
+func NewTranslatorUserServer(userUsecase usecase.UserUsecase, streamConcurrency int) pb.TranslatorUserServer {
 	return &userServer{
-		userUsecase: userUsecase,
+		userUsecase:       userUsecase,
+		streamConcurrency: streamConcurrency,
 	}
 }
 
@@ -34,28 +49,198 @@ func (s *userServer) DictionaryLookup(ctx context.Context, in *pb.DictionaryLook
 		return nil, status.New(codes.InvalidArgument, "bad request").Err()
 	}
 
-	results, err := s.userUsecase.DictionaryLookup(ctx, fromLang, toLang, in.Text)
+	switch in.Mode {
+	case pb.LookupMode_LOOKUP_MODE_BEST:
+		results, err := s.userUsecase.DictionaryLookup(ctx, fromLang, toLang, in.Text)
+		if err != nil {
+			return nil, s.lookupError(err)
+		}
+
+		return &pb.DictionaryLookupResponses{
+			Results: s.toDictionaryResponses(results),
+		}, nil
+	case pb.LookupMode_LOOKUP_MODE_ALL:
+		results, err := s.userUsecase.DictionaryLookupCandidates(ctx, fromLang, toLang, in.Text)
+		if err != nil {
+			return nil, s.lookupError(err)
+		}
+
+		return &pb.DictionaryLookupResponses{
+			Results: s.toCandidateDictionaryResponses(results),
+		}, nil
+	default:
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+}
+
+func (s *userServer) DictionaryLookupWithPos(ctx context.Context, in *pb.DictionaryLookupWithPosParameter) (*pb.DictionaryLookupResponse, error) {
+
+	fromLang, err := domain.NewLang2(in.FromLang2)
 	if err != nil {
-		return nil, err
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
 	}
 
-	dictionaryResponses := make([]*pb.DictionaryResponse, len(results))
-	for i, r := range results {
-		dictionaryResponses[i] = &pb.DictionaryResponse{
-			Lang2:      r.GetLang2().String(),
-			Text:       r.GetText(),
-			Pos:        int32(r.GetPos()),
-			Translated: r.GetTranslated(),
+	toLang, err := domain.NewLang2(in.ToLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	result, err := s.userUsecase.DictionaryLookupWithPos(ctx, fromLang, toLang, in.Text, domain.WordPos(in.Pos))
+	if err != nil {
+		return nil, s.lookupError(err)
+	}
+
+	return &pb.DictionaryLookupResponse{
+		Result: s.toDictionaryResponse(result),
+	}, nil
+}
+
+func (s *userServer) DictionaryLookupBatch(ctx context.Context, in *pb.DictionaryLookupBatchParameter) (*pb.DictionaryLookupBatchResponse, error) {
+	fromLang, err := domain.NewLang2(in.FromLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	toLang, err := domain.NewLang2(in.ToLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if len(in.Texts) == 0 || len(in.Texts) > dictionaryLookupBatchMaxTexts {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.userUsecase.DictionaryLookupBatch(ctx, fromLang, toLang, in.Texts)
+	if err != nil {
+		return nil, s.lookupError(err)
+	}
+
+	responses := make(map[string]*pb.DictionaryLookupResponses)
+	for text, translations := range results {
+		responses[text] = &pb.DictionaryLookupResponses{
+			Results: s.toDictionaryResponses(translations),
 		}
 	}
 
-	return &pb.DictionaryLookupResponses{
-		Results: dictionaryResponses,
+	return &pb.DictionaryLookupBatchResponse{
+		Results: responses,
 	}, nil
 }
 
-func (s *userServer) DictionaryLookupWithPos(ctx context.Context, in *pb.DictionaryLookupWithPosParameter) (*pb.DictionaryLookupResponse, error) {
+// DictionaryLookupStream sends the result of each text as soon as it is looked up. The order of the results is not guaranteed.
+// A text which fails to be looked up does not stop the stream and its result has the error code.
+func (s *userServer) DictionaryLookupStream(stream pb.TranslatorUser_DictionaryLookupStreamServer) error {
+	ctx := stream.Context()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var sendErr error
+	send := func(response *pb.DictionaryLookupStreamResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(response)
+	}
+	semaphore := make(chan struct{}, s.streamConcurrency)
+
+	var fromLang, toLang domain.Lang2
+	for i := 0; ; i++ {
+		in, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			wg.Wait()
+			return err
+		}
+
+		if i == 0 {
+			fromLang, err = domain.NewLang2(in.FromLang2)
+			if err != nil {
+				return status.New(codes.InvalidArgument, "bad request").Err()
+			}
 
+			toLang, err = domain.NewLang2(in.ToLang2)
+			if err != nil {
+				return status.New(codes.InvalidArgument, "bad request").Err()
+			}
+		} else if (in.FromLang2 != "" && in.FromLang2 != fromLang.String()) || (in.ToLang2 != "" && in.ToLang2 != toLang.String()) {
+			wg.Wait()
+			return status.New(codes.InvalidArgument, "the languages must not change in a stream").Err()
+		}
+
+		for _, text := range in.Texts {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				wg.Wait()
+				return ctx.Err()
+			}
+
+			wg.Add(1)
+			go func(text string) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+				send(s.dictionaryLookupStreamResponse(ctx, fromLang, toLang, text))
+			}(text)
+		}
+	}
+
+	wg.Wait()
+	return sendErr
+}
+
+// lookupError converts ErrUnsupportedLanguagePair into InvalidArgument
+func (s *userServer) lookupError(err error) error {
+	if errors.Is(err, service.ErrUnsupportedLanguagePair) {
+		return status.New(codes.InvalidArgument, "unsupported language pair").Err()
+	}
+	return err
+}
+
+func (s *userServer) dictionaryLookupStreamResponse(ctx context.Context, fromLang, toLang domain.Lang2, text string) *pb.DictionaryLookupStreamResponse {
+	logger := log.FromContext(ctx)
+
+	if len(text) == 0 {
+		return &pb.DictionaryLookupStreamResponse{
+			Text:    text,
+			Code:    int32(codes.InvalidArgument),
+			Message: "text is empty",
+		}
+	}
+
+	results, err := s.userUsecase.DictionaryLookup(ctx, fromLang, toLang, text)
+	if errors.Is(err, service.ErrUnsupportedLanguagePair) {
+		return &pb.DictionaryLookupStreamResponse{
+			Text:    text,
+			Code:    int32(codes.InvalidArgument),
+			Message: "unsupported language pair",
+		}
+	} else if errors.Is(err, service.ErrTranslationNotFound) {
+		return &pb.DictionaryLookupStreamResponse{
+			Text:    text,
+			Code:    int32(codes.NotFound),
+			Message: "translation not found",
+		}
+	} else if err != nil {
+		logger.Warnf("failed to DictionaryLookup. text: %s, err: %v", text, err)
+		return &pb.DictionaryLookupStreamResponse{
+			Text:    text,
+			Code:    int32(codes.Internal),
+			Message: "internal error",
+		}
+	}
+
+	return &pb.DictionaryLookupStreamResponse{
+		Text:    text,
+		Results: s.toDictionaryResponses(results),
+		Code:    int32(codes.OK),
+	}
+}
+
+func (s *userServer) DictionaryReverseLookup(ctx context.Context, in *pb.DictionaryReverseLookupParameter) (*pb.DictionaryLookupResponses, error) {
 	fromLang, err := domain.NewLang2(in.FromLang2)
 	if err != nil {
 		return nil, status.New(codes.InvalidArgument, "bad request").Err()
@@ -66,17 +251,157 @@ func (s *userServer) DictionaryLookupWithPos(ctx context.Context, in *pb.Diction
 		return nil, status.New(codes.InvalidArgument, "bad request").Err()
 	}
 
-	result, err := s.userUsecase.DictionaryLookupWithPos(ctx, fromLang, toLang, in.Text, domain.WordPos(in.Pos))
+	if len(in.Translated) == 0 {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.userUsecase.DictionaryReverseLookup(ctx, fromLang, toLang, in.Translated, in.Prefix)
 	if err != nil {
-		return nil, err
+		return nil, s.lookupError(err)
 	}
 
-	return &pb.DictionaryLookupResponse{
-		Result: &pb.DictionaryResponse{
-			Lang2:      result.GetLang2().String(),
-			Text:       result.GetText(),
-			Pos:        int32(result.GetPos()),
-			Translated: result.GetTranslated(),
-		},
+	return &pb.DictionaryLookupResponses{
+		Results: s.toDictionaryResponses(results),
+	}, nil
+}
+
+func (s *userServer) DictionaryExamples(ctx context.Context, in *pb.DictionaryExamplesParameter) (*pb.DictionaryExamplesResponse, error) {
+	fromLang, err := domain.NewLang2(in.FromLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	toLang, err := domain.NewLang2(in.ToLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if len(in.Text) == 0 || len(in.Translated) == 0 ||
+		utf8.RuneCountInString(in.Text) > dictionaryExamplesMaxTextLength ||
+		utf8.RuneCountInString(in.Translated) > dictionaryExamplesMaxTranslatedLength {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	results, err := s.userUsecase.DictionaryExamples(ctx, fromLang, toLang, in.Text, in.Translated)
+	if errors.Is(err, service.ErrExampleProviderNotFound) {
+		return nil, status.New(codes.Unimplemented, "examples are not available").Err()
+	} else if err != nil {
+		return nil, s.lookupError(err)
+	}
+
+	toSentence := func(e domain.ExampleSentence) *pb.ExampleSentence {
+		return &pb.ExampleSentence{
+			Prefix: e.Prefix,
+			Term:   e.Term,
+			Suffix: e.Suffix,
+		}
+	}
+	examples := make([]*pb.Example, len(results))
+	for i, e := range results {
+		examples[i] = &pb.Example{
+			Source: toSentence(e.GetSource()),
+			Target: toSentence(e.GetTarget()),
+		}
+	}
+
+	return &pb.DictionaryExamplesResponse{
+		Lang2:      toLang.String(),
+		Text:       in.Text,
+		Translated: in.Translated,
+		Results:    examples,
+	}, nil
+}
+
+func (s *userServer) Translate(ctx context.Context, in *pb.TranslateParameter) (*pb.TranslateResponse, error) {
+	var fromLang domain.Lang2
+	if in.FromLang2 != "" {
+		lang, err := domain.NewLang2(in.FromLang2)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, "bad request").Err()
+		}
+		fromLang = lang
+	}
+
+	toLang, err := domain.NewLang2(in.ToLang2)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	if len(in.Text) == 0 || utf8.RuneCountInString(in.Text) > translateMaxTextLength {
+		return nil, status.New(codes.InvalidArgument, "bad request").Err()
+	}
+
+	result, err := s.userUsecase.Translate(ctx, fromLang, toLang, in.Text)
+	if errors.Is(err, service.ErrTextTranslatorNotFound) {
+		return nil, status.New(codes.Unimplemented, "translation of texts is not available").Err()
+	} else if err != nil {
+		return nil, s.lookupError(err)
+	}
+
+	return &pb.TranslateResponse{
+		Text:              result.GetText(),
+		FromLang2:         result.GetFromLang().String(),
+		ToLang2:           result.GetToLang().String(),
+		Translated:        result.GetTranslated(),
+		Detected:          result.IsDetected(),
+		DetectedLangScore: result.GetDetectedLangScore(),
 	}, nil
 }
+
+func (s *userServer) toDictionaryResponse(t domain.Translation) *pb.DictionaryResponse {
+	return &pb.DictionaryResponse{
+		Lang2:            t.GetLang2().String(),
+		Text:             t.GetText(),
+		Pos:              int32(t.GetPos()),
+		Translated:       t.GetTranslated(),
+		Provider:         t.GetProvider(),
+		Confidence:       t.GetConfidence(),
+		NormalizedSource: t.GetNormalizedSource(),
+		PrefixWord:       t.GetPrefixWord(),
+		BackTranslations: toBackTranslationResponses(t.GetBackTranslations()),
+		Senses:           toSenseResponses(t.GetSenses()),
+		Labels:           t.GetAnnotation().Labels,
+		Tags:             t.GetAnnotation().Tags,
+		Level:            t.GetAnnotation().Level,
+	}
+}
+
+func toBackTranslationResponses(backTranslations []domain.BackTranslation) []*pb.BackTranslation {
+	results := make([]*pb.BackTranslation, len(backTranslations))
+	for i, b := range backTranslations {
+		results[i] = &pb.BackTranslation{
+			Text:           b.Text,
+			FrequencyCount: int32(b.FrequencyCount),
+		}
+	}
+	return results
+}
+
+func toSenseResponses(senses []domain.Sense) []*pb.Sense {
+	results := make([]*pb.Sense, len(senses))
+	for i, s := range senses {
+		results[i] = &pb.Sense{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: int32(s.Order),
+		}
+	}
+	return results
+}
+
+func (s *userServer) toDictionaryResponses(translations []domain.Translation) []*pb.DictionaryResponse {
+	dictionaryResponses := make([]*pb.DictionaryResponse, len(translations))
+	for i, t := range translations {
+		dictionaryResponses[i] = s.toDictionaryResponse(t)
+	}
+	return dictionaryResponses
+}
+
+func (s *userServer) toCandidateDictionaryResponses(candidates []domain.TranslationCandidate) []*pb.DictionaryResponse {
+	dictionaryResponses := make([]*pb.DictionaryResponse, len(candidates))
+	for i, t := range candidates {
+		dictionaryResponses[i] = s.toDictionaryResponse(t)
+		dictionaryResponses[i].Rank = int32(t.GetRank())
+	}
+	return dictionaryResponses
+}
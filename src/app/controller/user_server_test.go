@@ -0,0 +1,248 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/controller"
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+	usecase_mock "github.com/kujilabo/cocotola-translator-api/src/app/usecase/mock"
+	pb "github.com/kujilabo/cocotola-translator-api/src/proto"
+)
+
+func initUserClient(t *testing.T, userUsecase usecase.UserUsecase) pb.TranslatorUserClient {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterTranslatorUserServer(server, controller.NewTranslatorUserServer(userUsecase, 2))
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewTranslatorUserClient(conn)
+}
+
+func Test_userServer_DictionaryLookupStream(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", "azure")
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book").Return([]domain.Translation{book}, nil)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "pen").Return(nil, service.ErrTranslationNotFound)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "run").Return(nil, errors.New("service unavailable"))
+	client := initUserClient(t, userUsecase)
+
+	// when
+	// - texts are sent in two messages
+	stream, err := client.DictionaryLookupStream(bg)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.DictionaryLookupStreamParameter{FromLang2: "en", ToLang2: "ja", Texts: []string{"book", "pen"}}))
+	require.NoError(t, stream.Send(&pb.DictionaryLookupStreamParameter{Texts: []string{"run", ""}}))
+	require.NoError(t, stream.CloseSend())
+
+	responses := make(map[string]*pb.DictionaryLookupStreamResponse)
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		responses[resp.Text] = resp
+	}
+
+	// then
+	// - the failures do not stop the stream
+	assert.Equal(t, 4, len(responses))
+	assert.Equal(t, int32(codes.OK), responses["book"].Code)
+	assert.Equal(t, "本", responses["book"].Results[0].Translated)
+	assert.Equal(t, int32(codes.NotFound), responses["pen"].Code)
+	assert.Equal(t, int32(codes.Internal), responses["run"].Code)
+	assert.Equal(t, int32(codes.InvalidArgument), responses[""].Code)
+}
+
+func Test_userServer_DictionaryLookupStream_languageChanged(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", "azure")
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book").Return([]domain.Translation{book}, nil)
+	client := initUserClient(t, userUsecase)
+
+	// when
+	// - the second message has another language
+	stream, err := client.DictionaryLookupStream(bg)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.DictionaryLookupStreamParameter{FromLang2: "en", ToLang2: "ja", Texts: []string{"book"}}))
+	require.NoError(t, stream.Send(&pb.DictionaryLookupStreamParameter{FromLang2: "ja", ToLang2: "en", Texts: []string{"本"}}))
+	require.NoError(t, stream.CloseSend())
+
+	var recvErr error
+	for {
+		if _, recvErr = stream.Recv(); recvErr != nil {
+			break
+		}
+	}
+
+	// then
+	// - the stream is rejected and the text of the second message is not looked up
+	assert.Equal(t, codes.InvalidArgument, status.Code(recvErr))
+	userUsecase.AssertNotCalled(t, "DictionaryLookup", anythingOfContext, domain.Lang2JA, domain.Lang2EN, "本")
+}
+
+func Test_userServer_DictionaryLookup_Detail(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	book, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", "azure", domain.TranslationDetail{
+		Confidence:       0.6,
+		NormalizedSource: "book",
+		BackTranslations: []domain.BackTranslation{
+			{Text: "book", FrequencyCount: 7203},
+			{Text: "books", FrequencyCount: 1211},
+		},
+	})
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "Book").Return([]domain.Translation{book}, nil)
+	client := initUserClient(t, userUsecase)
+
+	// when
+	resp, err := client.DictionaryLookup(bg, &pb.DictionaryLookupParameter{FromLang2: "en", ToLang2: "ja", Text: "Book"})
+
+	// then
+	// - the detail of the translation is returned
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Results))
+	result := resp.Results[0]
+	assert.Equal(t, 0.6, result.Confidence)
+	assert.Equal(t, "book", result.NormalizedSource)
+	require.Equal(t, 2, len(result.BackTranslations))
+	assert.Equal(t, "books", result.BackTranslations[1].Text)
+	assert.Equal(t, int32(1211), result.BackTranslations[1].FrequencyCount)
+	assert.Equal(t, int32(0), result.Rank)
+}
+
+func Test_userServer_DictionaryLookup_InvalidLanguage(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	fr, err := domain.NewLang2("fr")
+	require.NoError(t, err)
+	userUsecase.On("DictionaryLookup", anythingOfContext, domain.Lang2EN, fr, "book").Return(nil, service.ErrUnsupportedLanguagePair)
+	client := initUserClient(t, userUsecase)
+
+	// when
+	// - the pair is not supported
+	_, err = client.DictionaryLookup(bg, &pb.DictionaryLookupParameter{FromLang2: "en", ToLang2: "fr", Text: "book"})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// when
+	// - the language is not an ISO 639-1 code
+	_, err = client.DictionaryLookup(bg, &pb.DictionaryLookupParameter{FromLang2: "en", ToLang2: "xx", Text: "book"})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	userUsecase.AssertNumberOfCalls(t, "DictionaryLookup", 1)
+}
+
+func Test_userServer_DictionaryExamples(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	example, err := domain.NewExample(domain.Lang2JA, "book", "本",
+		domain.ExampleSentence{Prefix: "I read a ", Term: "book", Suffix: "."},
+		domain.ExampleSentence{Prefix: "私は", Term: "本", Suffix: "を読んだ。"})
+	require.NoError(t, err)
+	userUsecase.On("DictionaryExamples", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "book", "本").Return([]domain.Example{example}, nil)
+	userUsecase.On("DictionaryExamples", anythingOfContext, domain.Lang2EN, domain.Lang2JA, "run", "走る").Return(nil, service.ErrExampleProviderNotFound)
+	client := initUserClient(t, userUsecase)
+
+	// when
+	resp, err := client.DictionaryExamples(bg, &pb.DictionaryExamplesParameter{FromLang2: "en", ToLang2: "ja", Text: "book", Translated: "本"})
+	// then
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Results))
+	assert.Equal(t, "を読んだ。", resp.Results[0].Target.Suffix)
+
+	// when
+	_, err = client.DictionaryExamples(bg, &pb.DictionaryExamplesParameter{FromLang2: "en", ToLang2: "ja", Text: "run", Translated: "走る"})
+	// then
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// when
+	// - the text is longer than the column
+	_, err = client.DictionaryExamples(bg, &pb.DictionaryExamplesParameter{FromLang2: "en", ToLang2: "ja", Text: strings.Repeat("a", 31), Translated: "本"})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// when
+	// - the translated is longer than the column
+	_, err = client.DictionaryExamples(bg, &pb.DictionaryExamplesParameter{FromLang2: "en", ToLang2: "ja", Text: "book", Translated: strings.Repeat("本", 101)})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	userUsecase.AssertNumberOfCalls(t, "DictionaryExamples", 2)
+}
+
+func Test_userServer_Translate(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	userUsecase := new(usecase_mock.UserUsecase)
+	// - the detected language has no ISO 639-1 code
+	translation, err := domain.NewTextTranslation("Aloha kakahiaka.", domain.Lang2Unknown, domain.Lang2JA, "おはよう。", true, 0.8)
+	require.NoError(t, err)
+	userUsecase.On("Translate", anythingOfContext, nil, domain.Lang2JA, "Aloha kakahiaka.").Return(translation, nil)
+	lang2AA, err := domain.NewLang2("aa")
+	require.NoError(t, err)
+	userUsecase.On("Translate", anythingOfContext, domain.Lang2JA, lang2AA, "本を読んだ。").Return(nil, service.ErrUnsupportedLanguagePair)
+	client := initUserClient(t, userUsecase)
+
+	// when
+	resp, err := client.Translate(bg, &pb.TranslateParameter{ToLang2: "ja", Text: "Aloha kakahiaka."})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "おはよう。", resp.Translated)
+	assert.Equal(t, "", resp.FromLang2)
+	assert.True(t, resp.Detected)
+
+	// when
+	// - the pair is not supported
+	_, err = client.Translate(bg, &pb.TranslateParameter{FromLang2: "ja", ToLang2: "aa", Text: "本を読んだ。"})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// when
+	// - the text is longer than the limit of REST
+	_, err = client.Translate(bg, &pb.TranslateParameter{FromLang2: "en", ToLang2: "ja", Text: strings.Repeat("a", 5001)})
+	// then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	userUsecase.AssertNumberOfCalls(t, "Translate", 2)
+}
@@ -0,0 +1,66 @@
+//go:generate mockery --output mock --name Example
+package domain
+
+import (
+	lib "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
+)
+
+// ExampleSentence is a sentence split around the term so that the term can be highlighted
+type ExampleSentence struct {
+	Prefix string
+	Term   string
+	Suffix string
+}
+
+func (s ExampleSentence) String() string {
+	return s.Prefix + s.Term + s.Suffix
+}
+
+// Example is a sentence which contains the text and its translation which contains the translated text
+type Example interface {
+	GetLang2() Lang2
+	GetText() string
+	GetTranslated() string
+	GetSource() ExampleSentence
+	GetTarget() ExampleSentence
+}
+
+type example struct {
+	Lang2      Lang2
+	Text       string `validate:"required"`
+	Translated string `validate:"required"`
+	Source     ExampleSentence
+	Target     ExampleSentence
+}
+
+func NewExample(lang2 Lang2, text, translated string, source, target ExampleSentence) (Example, error) {
+	m := &example{
+		Lang2:      lang2,
+		Text:       text,
+		Translated: translated,
+		Source:     source,
+		Target:     target,
+	}
+
+	return m, lib.Validator.Struct(m)
+}
+
+func (e *example) GetLang2() Lang2 {
+	return e.Lang2
+}
+
+func (e *example) GetText() string {
+	return e.Text
+}
+
+func (e *example) GetTranslated() string {
+	return e.Translated
+}
+
+func (e *example) GetSource() ExampleSentence {
+	return e.Source
+}
+
+func (e *example) GetTarget() ExampleSentence {
+	return e.Target
+}
@@ -3,6 +3,8 @@ package domain
 var (
 	Lang2JA Lang2
 	Lang2EN Lang2
+	// Lang2Unknown is a language which has no ISO 639-1 code such as the language detected by a provider. Its code is empty.
+	Lang2Unknown Lang2
 
 	Lang3JPN Lang3
 	Lang3ENG Lang3
@@ -23,6 +25,8 @@ func init() {
 		panic(err)
 	}
 
+	Lang2Unknown = &lang2{value: ""}
+
 	Lang3JPN, err = NewLang3("jpn")
 	if err != nil {
 		panic(err)
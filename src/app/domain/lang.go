@@ -6,9 +6,47 @@ const Lang2Len = 2
 const Lang3Len = 3
 const Lang5Len = 5
 
+// iso639Lang2ToLang3 maps all the ISO 639-1 codes to their ISO 639-3 codes.
+// A macrolanguage such as "ar" is mapped to the code of the macrolanguage, which is the same as its ISO 639-2/T code.
+var iso639Lang2ToLang3 = map[string]string{
+	"aa": "aar", "ab": "abk", "ae": "ave", "af": "afr", "ak": "aka", "am": "amh", "an": "arg", "ar": "ara",
+	"as": "asm", "av": "ava", "ay": "aym", "az": "aze", "ba": "bak", "be": "bel", "bg": "bul", "bi": "bis",
+	"bm": "bam", "bn": "ben", "bo": "bod", "br": "bre", "bs": "bos", "ca": "cat", "ce": "che", "ch": "cha",
+	"co": "cos", "cr": "cre", "cs": "ces", "cu": "chu", "cv": "chv", "cy": "cym", "da": "dan", "de": "deu",
+	"dv": "div", "dz": "dzo", "ee": "ewe", "el": "ell", "en": "eng", "eo": "epo", "es": "spa", "et": "est",
+	"eu": "eus", "fa": "fas", "ff": "ful", "fi": "fin", "fj": "fij", "fo": "fao", "fr": "fra", "fy": "fry",
+	"ga": "gle", "gd": "gla", "gl": "glg", "gn": "grn", "gu": "guj", "gv": "glv", "ha": "hau", "he": "heb",
+	"hi": "hin", "ho": "hmo", "hr": "hrv", "ht": "hat", "hu": "hun", "hy": "hye", "hz": "her", "ia": "ina",
+	"id": "ind", "ie": "ile", "ig": "ibo", "ii": "iii", "ik": "ipk", "io": "ido", "is": "isl", "it": "ita",
+	"iu": "iku", "ja": "jpn", "jv": "jav", "ka": "kat", "kg": "kon", "ki": "kik", "kj": "kua", "kk": "kaz",
+	"kl": "kal", "km": "khm", "kn": "kan", "ko": "kor", "kr": "kau", "ks": "kas", "ku": "kur", "kv": "kom",
+	"kw": "cor", "ky": "kir", "la": "lat", "lb": "ltz", "lg": "lug", "li": "lim", "ln": "lin", "lo": "lao",
+	"lt": "lit", "lu": "lub", "lv": "lav", "mg": "mlg", "mh": "mah", "mi": "mri", "mk": "mkd", "ml": "mal",
+	"mn": "mon", "mr": "mar", "ms": "msa", "mt": "mlt", "my": "mya", "na": "nau", "nb": "nob", "nd": "nde",
+	"ne": "nep", "ng": "ndo", "nl": "nld", "nn": "nno", "no": "nor", "nr": "nbl", "nv": "nav", "ny": "nya",
+	"oc": "oci", "oj": "oji", "om": "orm", "or": "ori", "os": "oss", "pa": "pan", "pi": "pli", "pl": "pol",
+	"ps": "pus", "pt": "por", "qu": "que", "rm": "roh", "rn": "run", "ro": "ron", "ru": "rus", "rw": "kin",
+	"sa": "san", "sc": "srd", "sd": "snd", "se": "sme", "sg": "sag", "si": "sin", "sk": "slk", "sl": "slv",
+	"sm": "smo", "sn": "sna", "so": "som", "sq": "sqi", "sr": "srp", "ss": "ssw", "st": "sot", "su": "sun",
+	"sv": "swe", "sw": "swa", "ta": "tam", "te": "tel", "tg": "tgk", "th": "tha", "ti": "tir", "tk": "tuk",
+	"tl": "tgl", "tn": "tsn", "to": "ton", "tr": "tur", "ts": "tso", "tt": "tat", "tw": "twi", "ty": "tah",
+	"ug": "uig", "uk": "ukr", "ur": "urd", "uz": "uzb", "ve": "ven", "vi": "vie", "vo": "vol", "wa": "wln",
+	"wo": "wol", "xh": "xho", "yi": "yid", "yo": "yor", "za": "zha", "zh": "zho", "zu": "zul",
+}
+
+// iso639Lang3ToLang2 is the reverse of iso639Lang2ToLang3
+var iso639Lang3ToLang2 = func() map[string]string {
+	m := make(map[string]string, len(iso639Lang2ToLang3))
+	for lang2, lang3 := range iso639Lang2ToLang3 {
+		m[lang3] = lang2
+	}
+	return m
+}()
+
+// Lang2 is an ISO 639-1 code such as "en"
 type Lang2 interface {
 	String() string
-	ToLang3() string
+	ToLang3() Lang3
 }
 
 type lang2 struct {
@@ -19,6 +57,9 @@ func NewLang2(lang string) (Lang2, error) {
 	if len(lang) != Lang2Len {
 		return nil, liberrors.Errorf("invalid parameter. Lang2: %s", lang)
 	}
+	if _, ok := iso639Lang2ToLang3[lang]; !ok {
+		return nil, liberrors.Errorf("unknown ISO 639-1 code. Lang2: %s", lang)
+	}
 
 	return &lang2{
 		value: lang,
@@ -29,19 +70,16 @@ func (l *lang2) String() string {
 	return l.value
 }
 
-func (l *lang2) ToLang3() string {
-	switch l.value {
-	case "en":
-		return "eng"
-	case "ja":
-		return "jpn"
-	default:
-		return "___"
+func (l *lang2) ToLang3() Lang3 {
+	return &lang3{
+		value: iso639Lang2ToLang3[l.value],
 	}
 }
 
+// Lang3 is an ISO 639-3 code which has the ISO 639-1 equivalent such as "eng"
 type Lang3 interface {
 	String() string
+	ToLang2() Lang2
 }
 
 type lang3 struct {
@@ -52,6 +90,9 @@ func NewLang3(lang string) (Lang3, error) {
 	if len(lang) != Lang3Len {
 		return nil, liberrors.Errorf("invalid parameter. Lang3: %s", lang)
 	}
+	if _, ok := iso639Lang3ToLang2[lang]; !ok {
+		return nil, liberrors.Errorf("unknown ISO 639-3 code. Lang3: %s", lang)
+	}
 
 	return &lang3{
 		value: lang,
@@ -62,9 +103,17 @@ func (l *lang3) String() string {
 	return l.value
 }
 
+func (l *lang3) ToLang2() Lang2 {
+	return &lang2{
+		value: iso639Lang3ToLang2[l.value],
+	}
+}
+
+// Lang5 is a BCP 47 tag which consists of an ISO 639-1 code and an ISO 3166-1 alpha-2 region such as "en-US"
 type Lang5 interface {
 	String() string
-	ToLang2() string
+	ToLang2() Lang2
+	GetRegion() string
 }
 
 type lang5 struct {
@@ -72,9 +121,17 @@ type lang5 struct {
 }
 
 func NewLang5(lang string) (Lang5, error) {
-	if len(lang) != Lang5Len {
+	if len(lang) != Lang5Len || lang[Lang2Len] != '-' {
 		return nil, liberrors.Errorf("invalid parameter. Lang5: %s", lang)
 	}
+	if _, ok := iso639Lang2ToLang3[lang[:Lang2Len]]; !ok {
+		return nil, liberrors.Errorf("unknown ISO 639-1 code. Lang5: %s", lang)
+	}
+	for _, c := range lang[Lang2Len+1:] {
+		if c < 'A' || 'Z' < c {
+			return nil, liberrors.Errorf("invalid region. Lang5: %s", lang)
+		}
+	}
 
 	return &lang5{
 		value: lang,
@@ -85,13 +142,52 @@ func (l *lang5) String() string {
 	return l.value
 }
 
-func (l *lang5) ToLang2() string {
-	switch l.value {
-	case "eng":
-		return "en"
-	case "jpn":
-		return "ja"
-	default:
-		return "__"
+func (l *lang5) ToLang2() Lang2 {
+	return &lang2{
+		value: l.value[:Lang2Len],
+	}
+}
+
+func (l *lang5) GetRegion() string {
+	return l.value[Lang2Len+1:]
+}
+
+// LanguagePair is the pair of the languages which a text is translated from and into
+type LanguagePair struct {
+	FromLang Lang2
+	ToLang   Lang2
+}
+
+func NewLanguagePair(fromLang, toLang string) (LanguagePair, error) {
+	from, err := NewLang2(fromLang)
+	if err != nil {
+		return LanguagePair{}, err
+	}
+	to, err := NewLang2(toLang)
+	if err != nil {
+		return LanguagePair{}, err
+	}
+	if from.String() == to.String() {
+		return LanguagePair{}, liberrors.Errorf("invalid parameter. the same languages. LanguagePair: %s-%s", fromLang, toLang)
+	}
+	return LanguagePair{FromLang: from, ToLang: to}, nil
+}
+
+// Matches returns true if the pair is fromLang to toLang
+func (p LanguagePair) Matches(fromLang, toLang Lang2) bool {
+	return p.FromLang.String() == fromLang.String() && p.ToLang.String() == toLang.String()
+}
+
+func (p LanguagePair) String() string {
+	return p.FromLang.String() + "-" + p.ToLang.String()
+}
+
+// ContainsLanguagePair returns true if pairs contains the pair of fromLang to toLang
+func ContainsLanguagePair(pairs []LanguagePair, fromLang, toLang Lang2) bool {
+	for _, p := range pairs {
+		if p.Matches(fromLang, toLang) {
+			return true
+		}
 	}
+	return false
 }
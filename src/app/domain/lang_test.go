@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+)
+
+func TestNewLang2(t *testing.T) {
+	tests := []struct {
+		name      string
+		lang      string
+		wantLang3 string
+		wantErr   bool
+	}{
+		{name: "english", lang: "en", wantLang3: "eng"},
+		{name: "french", lang: "fr", wantLang3: "fra"},
+		{name: "chinese", lang: "zh", wantLang3: "zho"},
+		{name: "unknown", lang: "xx", wantErr: true},
+		{name: "upper case", lang: "EN", wantErr: true},
+		{name: "too long", lang: "eng", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang2, err := domain.NewLang2(tt.lang)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLang3, lang2.ToLang3().String())
+			assert.Equal(t, tt.lang, lang2.ToLang3().ToLang2().String())
+		})
+	}
+}
+
+func TestNewLang3(t *testing.T) {
+	lang3, err := domain.NewLang3("deu")
+	require.NoError(t, err)
+	assert.Equal(t, "de", lang3.ToLang2().String())
+
+	// - ISO 639-3 codes without ISO 639-1 equivalents are not supported
+	_, err = domain.NewLang3("yue")
+	assert.Error(t, err)
+}
+
+func TestNewLang5(t *testing.T) {
+	tests := []struct {
+		name       string
+		lang       string
+		wantLang2  string
+		wantRegion string
+		wantErr    bool
+	}{
+		{name: "american english", lang: "en-US", wantLang2: "en", wantRegion: "US"},
+		{name: "brazilian portuguese", lang: "pt-BR", wantLang2: "pt", wantRegion: "BR"},
+		{name: "unknown language", lang: "xx-US", wantErr: true},
+		{name: "lower case region", lang: "en-us", wantErr: true},
+		{name: "underscore", lang: "en_US", wantErr: true},
+		{name: "script", lang: "zh-Hans", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang5, err := domain.NewLang5(tt.lang)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLang2, lang5.ToLang2().String())
+			assert.Equal(t, tt.wantRegion, lang5.GetRegion())
+		})
+	}
+}
+
+func TestNewLanguagePair(t *testing.T) {
+	pair, err := domain.NewLanguagePair("en", "ja")
+	require.NoError(t, err)
+	assert.Equal(t, "en-ja", pair.String())
+	assert.True(t, domain.ContainsLanguagePair([]domain.LanguagePair{pair}, domain.Lang2EN, domain.Lang2JA))
+	assert.False(t, domain.ContainsLanguagePair([]domain.LanguagePair{pair}, domain.Lang2JA, domain.Lang2EN))
+
+	_, err = domain.NewLanguagePair("en", "en")
+	assert.Error(t, err)
+}
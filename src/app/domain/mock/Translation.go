@@ -3,12 +3,12 @@
 package mocks
 
 import (
+	time "time"
+
 	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
-
-	time "time"
 )
 
 // Translation is an autogenerated mock type for the Translation type
@@ -16,6 +16,50 @@ type Translation struct {
 	mock.Mock
 }
 
+// GetAnnotation provides a mock function with given fields:
+func (_m *Translation) GetAnnotation() domain.TranslationAnnotation {
+	ret := _m.Called()
+
+	var r0 domain.TranslationAnnotation
+	if rf, ok := ret.Get(0).(func() domain.TranslationAnnotation); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.TranslationAnnotation)
+	}
+
+	return r0
+}
+
+// GetBackTranslations provides a mock function with given fields:
+func (_m *Translation) GetBackTranslations() []domain.BackTranslation {
+	ret := _m.Called()
+
+	var r0 []domain.BackTranslation
+	if rf, ok := ret.Get(0).(func() []domain.BackTranslation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BackTranslation)
+		}
+	}
+
+	return r0
+}
+
+// GetConfidence provides a mock function with given fields:
+func (_m *Translation) GetConfidence() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
 // GetCreatedAt provides a mock function with given fields:
 func (_m *Translation) GetCreatedAt() time.Time {
 	ret := _m.Called()
@@ -46,6 +90,20 @@ func (_m *Translation) GetLang2() domain.Lang2 {
 	return r0
 }
 
+// GetNormalizedSource provides a mock function with given fields:
+func (_m *Translation) GetNormalizedSource() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetPos provides a mock function with given fields:
 func (_m *Translation) GetPos() domain.WordPos {
 	ret := _m.Called()
@@ -60,6 +118,20 @@ func (_m *Translation) GetPos() domain.WordPos {
 	return r0
 }
 
+// GetPrefixWord provides a mock function with given fields:
+func (_m *Translation) GetPrefixWord() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetProvider provides a mock function with given fields:
 func (_m *Translation) GetProvider() string {
 	ret := _m.Called()
@@ -74,6 +146,22 @@ func (_m *Translation) GetProvider() string {
 	return r0
 }
 
+// GetSenses provides a mock function with given fields:
+func (_m *Translation) GetSenses() []domain.Sense {
+	ret := _m.Called()
+
+	var r0 []domain.Sense
+	if rf, ok := ret.Get(0).(func() []domain.Sense); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Sense)
+		}
+	}
+
+	return r0
+}
+
 // GetText provides a mock function with given fields:
 func (_m *Translation) GetText() string {
 	ret := _m.Called()
@@ -130,6 +218,20 @@ func (_m *Translation) GetVersion() int {
 	return r0
 }
 
+// IsDisabled provides a mock function with given fields:
+func (_m *Translation) IsDisabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NewTranslation creates a new instance of Translation. It also registers a cleanup function to assert the mocks expectations.
 func NewTranslation(t testing.TB) *Translation {
 	mock := &Translation{}
@@ -0,0 +1,66 @@
+//go:generate mockery --output mock --name TextTranslation
+package domain
+
+import (
+	lib "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
+)
+
+// TextTranslation is a translation of a free text such as a sentence
+type TextTranslation interface {
+	GetText() string
+	// GetFromLang returns the language of the text. It is detected by the provider unless it is specified.
+	// It is Lang2Unknown if the detected language has no ISO 639-1 code.
+	GetFromLang() Lang2
+	GetToLang() Lang2
+	GetTranslated() string
+	// IsDetected returns whether the language of the text was detected by the provider
+	IsDetected() bool
+	// GetDetectedLangScore returns the confidence of the detected language between 0 and 1. It is 0 unless the language was detected.
+	GetDetectedLangScore() float64
+}
+
+type textTranslation struct {
+	Text              string `validate:"required"`
+	FromLang          Lang2  `validate:"required"`
+	ToLang            Lang2  `validate:"required"`
+	Translated        string
+	Detected          bool
+	DetectedLangScore float64 `validate:"gte=0,lte=1"`
+}
+
+func NewTextTranslation(text string, fromLang, toLang Lang2, translated string, detected bool, detectedLangScore float64) (TextTranslation, error) {
+	m := &textTranslation{
+		Text:              text,
+		FromLang:          fromLang,
+		ToLang:            toLang,
+		Translated:        translated,
+		Detected:          detected,
+		DetectedLangScore: detectedLangScore,
+	}
+
+	return m, lib.Validator.Struct(m)
+}
+
+func (t *textTranslation) GetText() string {
+	return t.Text
+}
+
+func (t *textTranslation) GetFromLang() Lang2 {
+	return t.FromLang
+}
+
+func (t *textTranslation) GetToLang() Lang2 {
+	return t.ToLang
+}
+
+func (t *textTranslation) GetTranslated() string {
+	return t.Translated
+}
+
+func (t *textTranslation) IsDetected() bool {
+	return t.Detected
+}
+
+func (t *textTranslation) GetDetectedLangScore() float64 {
+	return t.DetectedLangScore
+}
@@ -16,6 +16,56 @@ type Translation interface {
 	GetLang2() Lang2
 	GetTranslated() string
 	GetProvider() string
+	// GetConfidence returns how reliable the translation is between 0 and 1. Custom translations have 1.
+	GetConfidence() float64
+	// GetNormalizedSource returns the normalized form of the text which the provider looked up
+	GetNormalizedSource() string
+	// GetPrefixWord returns the word to be displayed before the translated text such as a gendered determiner
+	GetPrefixWord() string
+	// GetBackTranslations returns the translations of the translated text back into the language of the text
+	GetBackTranslations() []BackTranslation
+	// IsDisabled returns true if the custom translation has been removed.
+	// It is a tombstone which suppresses the translations of the providers for the same text and pos.
+	IsDisabled() bool
+	// GetSenses returns the meanings of the custom translation in display order. The translations of the providers have no senses.
+	GetSenses() []Sense
+	// GetAnnotation returns the information which editors add to the custom translation
+	GetAnnotation() TranslationAnnotation
+}
+
+// TranslationAnnotation is the information which editors add to the custom translation.
+// Labels are register labels such as formal, slang or archaic, and Tags are domain tags such as business or medical.
+// Level is a CEFR level (A1-C2) or a JLPT level (N5-N1).
+type TranslationAnnotation struct {
+	Note   string   `validate:"max=500"`
+	Labels []string `validate:"dive,required,max=30"`
+	Tags   []string `validate:"dive,required,max=30"`
+	Level  string   `validate:"omitempty,oneof=A1 A2 B1 B2 C1 C2 N1 N2 N3 N4 N5"`
+}
+
+// Sense is one of the meanings of the custom translation.
+// Order is the display order, and Note is an optional remark such as a usage or a register.
+type Sense struct {
+	Gloss string `validate:"required,max=100"`
+	Note  string `validate:"max=200"`
+	Order int    `validate:"gte=0"`
+}
+
+// BackTranslation is a translation of the translated text back into the language of the text.
+// FrequencyCount is the number of times the pair appears in the corpus of the provider.
+type BackTranslation struct {
+	Text           string
+	FrequencyCount int
+}
+
+// TranslationDetail is the information which providers return in addition to the translated text
+type TranslationDetail struct {
+	Confidence       float64 `validate:"gte=0,lte=1"`
+	NormalizedSource string
+	PrefixWord       string
+	BackTranslations []BackTranslation
+	Senses           []Sense `validate:"dive"`
+	Annotation       TranslationAnnotation
 }
 
 type translation struct {
@@ -27,9 +77,15 @@ type translation struct {
 	Lang2      Lang2
 	Translated string
 	Provider   string
+	Detail     TranslationDetail
+	Disabled   bool
 }
 
 func NewTranslation(version int, createdAt time.Time, updatedAt time.Time, text string, pos WordPos, lang2 Lang2, translated, provider string) (Translation, error) {
+	return NewTranslationWithDetail(version, createdAt, updatedAt, text, pos, lang2, translated, provider, TranslationDetail{})
+}
+
+func NewTranslationWithDetail(version int, createdAt time.Time, updatedAt time.Time, text string, pos WordPos, lang2 Lang2, translated, provider string, detail TranslationDetail) (Translation, error) {
 	m := &translation{
 		// ID:         id,
 		Version:    version,
@@ -40,6 +96,25 @@ func NewTranslation(version int, createdAt time.Time, updatedAt time.Time, text
 		Lang2:      lang2,
 		Translated: translated,
 		Provider:   provider,
+		Detail:     detail,
+	}
+
+	return m, lib.Validator.Struct(m)
+}
+
+// NewDisabledTranslation returns the removed custom translation which suppresses the translations of the providers
+func NewDisabledTranslation(version int, createdAt time.Time, updatedAt time.Time, text string, pos WordPos, lang2 Lang2, translated, provider string, detail TranslationDetail) (Translation, error) {
+	m := &translation{
+		Version:    version,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+		Text:       text,
+		Pos:        pos,
+		Lang2:      lang2,
+		Translated: translated,
+		Provider:   provider,
+		Detail:     detail,
+		Disabled:   true,
 	}
 
 	return m, lib.Validator.Struct(m)
@@ -80,3 +155,31 @@ func (t *translation) GetTranslated() string {
 func (t *translation) GetProvider() string {
 	return t.Provider
 }
+
+func (t *translation) GetConfidence() float64 {
+	return t.Detail.Confidence
+}
+
+func (t *translation) GetNormalizedSource() string {
+	return t.Detail.NormalizedSource
+}
+
+func (t *translation) GetPrefixWord() string {
+	return t.Detail.PrefixWord
+}
+
+func (t *translation) GetBackTranslations() []BackTranslation {
+	return t.Detail.BackTranslations
+}
+
+func (t *translation) IsDisabled() bool {
+	return t.Disabled
+}
+
+func (t *translation) GetSenses() []Sense {
+	return t.Detail.Senses
+}
+
+func (t *translation) GetAnnotation() TranslationAnnotation {
+	return t.Detail.Annotation
+}
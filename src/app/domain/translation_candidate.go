@@ -0,0 +1,31 @@
+//go:generate mockery --output mock --name TranslationCandidate
+package domain
+
+import (
+	lib "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
+)
+
+// TranslationCandidate is one of the translations of a text and a pos.
+// Rank starts with 1 in the order of confidence.
+type TranslationCandidate interface {
+	Translation
+	GetRank() int
+}
+
+type translationCandidate struct {
+	Translation `validate:"required"`
+	Rank        int `validate:"gte=1"`
+}
+
+func NewTranslationCandidate(translation Translation, rank int) (TranslationCandidate, error) {
+	m := &translationCandidate{
+		Translation: translation,
+		Rank:        rank,
+	}
+
+	return m, lib.Validator.Struct(m)
+}
+
+func (t *translationCandidate) GetRank() int {
+	return t.Rank
+}
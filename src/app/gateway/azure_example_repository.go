@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	libG "github.com/kujilabo/cocotola-translator-api/src/lib/gateway"
+)
+
+type azureExampleRepository struct {
+	db *gorm.DB
+}
+
+// azureExampleDBEntity is a looked up pair of a text and its translation. Its examples are stored in azure_example_sentence.
+type azureExampleDBEntity struct {
+	Text       string
+	FromLang2  string
+	Lang2      string
+	Translated string
+	CreatedAt  time.Time
+}
+
+func (e *azureExampleDBEntity) TableName() string {
+	return "azure_example"
+}
+
+// azureExampleSentenceDBEntity is an example of a pair. Rank is the order in the result of azure.
+type azureExampleSentenceDBEntity struct {
+	Text         string
+	FromLang2    string
+	Lang2        string
+	Translated   string
+	Rank         int
+	SourcePrefix string
+	SourceTerm   string
+	SourceSuffix string
+	TargetPrefix string
+	TargetTerm   string
+	TargetSuffix string
+}
+
+func (e *azureExampleSentenceDBEntity) TableName() string {
+	return "azure_example_sentence"
+}
+
+func (e *azureExampleSentenceDBEntity) toAzureExample() service.AzureExample {
+	return service.AzureExample{
+		Source: domain.ExampleSentence{
+			Prefix: e.SourcePrefix,
+			Term:   e.SourceTerm,
+			Suffix: e.SourceSuffix,
+		},
+		Target: domain.ExampleSentence{
+			Prefix: e.TargetPrefix,
+			Term:   e.TargetTerm,
+			Suffix: e.TargetSuffix,
+		},
+	}
+}
+
+func NewAzureExampleRepository(db *gorm.DB) service.AzureExampleRepository {
+	return &azureExampleRepository{
+		db: db,
+	}
+}
+
+func (r *azureExampleRepository) Add(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string, examples []service.AzureExample) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity := azureExampleDBEntity{
+			Text:       text,
+			FromLang2:  fromLang.String(),
+			Lang2:      toLang.String(),
+			Translated: translated,
+		}
+		if result := tx.Create(&entity); result.Error != nil {
+			return libG.ConvertDuplicatedError(result.Error, service.ErrAzureExampleAlreadyExists)
+		}
+
+		if len(examples) == 0 {
+			return nil
+		}
+
+		sentences := make([]azureExampleSentenceDBEntity, len(examples))
+		for i, e := range examples {
+			sentences[i] = azureExampleSentenceDBEntity{
+				Text:         text,
+				FromLang2:    fromLang.String(),
+				Lang2:        toLang.String(),
+				Translated:   translated,
+				Rank:         i,
+				SourcePrefix: e.Source.Prefix,
+				SourceTerm:   e.Source.Term,
+				SourceSuffix: e.Source.Suffix,
+				TargetPrefix: e.Target.Prefix,
+				TargetTerm:   e.Target.Term,
+				TargetSuffix: e.Target.Suffix,
+			}
+		}
+		if result := tx.Create(&sentences); result.Error != nil {
+			return libG.ConvertDuplicatedError(result.Error, service.ErrAzureExampleAlreadyExists)
+		}
+		return nil
+	})
+}
+
+func (r *azureExampleRepository) Find(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string) ([]service.AzureExample, error) {
+	entity := azureExampleDBEntity{}
+	if result := r.db.Where(&azureExampleDBEntity{
+		Text:       text,
+		FromLang2:  fromLang.String(),
+		Lang2:      toLang.String(),
+		Translated: translated,
+	}).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, service.ErrExampleNotFound
+		}
+		return nil, result.Error
+	}
+
+	sentences := []azureExampleSentenceDBEntity{}
+	if result := r.db.Where(&azureExampleSentenceDBEntity{
+		Text:       text,
+		FromLang2:  fromLang.String(),
+		Lang2:      toLang.String(),
+		Translated: translated,
+	}).Order("`rank`").Find(&sentences); result.Error != nil {
+		return nil, result.Error
+	}
+
+	examples := make([]service.AzureExample, len(sentences))
+	for i, s := range sentences {
+		examples[i] = s.toAzureExample()
+	}
+	return examples, nil
+}
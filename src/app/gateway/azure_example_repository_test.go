@@ -0,0 +1,63 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+func Test_azureExampleRepository(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		for _, table := range []string{"azure_example_sentence", "azure_example"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			require.NoError(t, result.Error)
+		}
+
+		// given
+		r := gateway.NewAzureExampleRepository(db)
+		bookExamples := []service.AzureExample{
+			{
+				Source: domain.ExampleSentence{Prefix: "I read a ", Term: "book", Suffix: "."},
+				Target: domain.ExampleSentence{Prefix: "私は", Term: "本", Suffix: "を読んだ。"},
+			},
+			{
+				Source: domain.ExampleSentence{Prefix: "", Term: "Books", Suffix: " are heavy."},
+				Target: domain.ExampleSentence{Prefix: "", Term: "本", Suffix: "は重い。"},
+			},
+		}
+		require.NoError(t, r.Add(bg, domain.Lang2EN, domain.Lang2JA, "book", "本", bookExamples), driverName)
+		require.NoError(t, r.Add(bg, domain.Lang2EN, domain.Lang2JA, "book", "帳簿", []service.AzureExample{}), driverName)
+
+		// when
+		got, err := r.Find(bg, domain.Lang2EN, domain.Lang2JA, "book", "本")
+		// then
+		// - examples are returned in rank order
+		require.NoError(t, err, driverName)
+		assert.Equal(t, bookExamples, got, driverName)
+
+		// when
+		got, err = r.Find(bg, domain.Lang2EN, domain.Lang2JA, "book", "帳簿")
+		// then
+		// - a pair without examples is cached
+		require.NoError(t, err, driverName)
+		assert.Equal(t, 0, len(got), driverName)
+
+		// when
+		_, err = r.Find(bg, domain.Lang2EN, domain.Lang2JA, "book", "書籍")
+		// then
+		assert.ErrorIs(t, err, service.ErrExampleNotFound, driverName)
+
+		// when
+		err = r.Add(bg, domain.Lang2EN, domain.Lang2JA, "book", "本", bookExamples)
+		// then
+		assert.ErrorIs(t, err, service.ErrAzureExampleAlreadyExists, driverName)
+	}
+}
@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	libG "github.com/kujilabo/cocotola-translator-api/src/lib/gateway"
+)
+
+type azureTextTranslationRepository struct {
+	db *gorm.DB
+}
+
+// azureTextTranslationDBEntity is a translated text. FromLang is empty if the language was detected, in which case DetectedLang is the detected one.
+type azureTextTranslationDBEntity struct {
+	TextHash          string
+	FromLang          string
+	ToLang            string
+	Text              string
+	Translated        string
+	DetectedLang      string
+	DetectedLangScore float64
+	CreatedAt         time.Time
+}
+
+func (e *azureTextTranslationDBEntity) TableName() string {
+	return "azure_text_translation"
+}
+
+func (e *azureTextTranslationDBEntity) toAzureTextTranslation() (*service.AzureTextTranslation, error) {
+	if e.FromLang != "" {
+		fromLang, err := domain.NewLang2(e.FromLang)
+		if err != nil {
+			return nil, err
+		}
+		return &service.AzureTextTranslation{
+			FromLang:   fromLang,
+			Translated: e.Translated,
+		}, nil
+	}
+
+	// the detected language is empty if it has no ISO 639-1 code
+	detectedLang := domain.Lang2Unknown
+	if e.DetectedLang != "" {
+		lang, err := domain.NewLang2(e.DetectedLang)
+		if err != nil {
+			return nil, err
+		}
+		detectedLang = lang
+	}
+	return &service.AzureTextTranslation{
+		FromLang:          detectedLang,
+		Translated:        e.Translated,
+		Detected:          true,
+		DetectedLangScore: e.DetectedLangScore,
+	}, nil
+}
+
+func NewAzureTextTranslationRepository(db *gorm.DB) service.AzureTextTranslationRepository {
+	return &azureTextTranslationRepository{
+		db: db,
+	}
+}
+
+// hashText returns the key of the text. Texts are too long to be keys.
+func (r *azureTextTranslationRepository) hashText(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+func (r *azureTextTranslationRepository) langString(lang domain.Lang2) string {
+	if lang == nil {
+		return ""
+	}
+	return lang.String()
+}
+
+func (r *azureTextTranslationRepository) Add(ctx context.Context, fromLang, toLang domain.Lang2, text string, result *service.AzureTextTranslation) error {
+	entity := azureTextTranslationDBEntity{
+		TextHash:   r.hashText(text),
+		FromLang:   r.langString(fromLang),
+		ToLang:     toLang.String(),
+		Text:       text,
+		Translated: result.Translated,
+	}
+	if result.Detected {
+		entity.DetectedLang = result.FromLang.String()
+		entity.DetectedLangScore = result.DetectedLangScore
+	}
+
+	if result := r.db.Create(&entity); result.Error != nil {
+		return libG.ConvertDuplicatedError(result.Error, service.ErrAzureTextTranslationAlreadyExists)
+	}
+	return nil
+}
+
+func (r *azureTextTranslationRepository) Find(ctx context.Context, fromLang, toLang domain.Lang2, text string) (*service.AzureTextTranslation, error) {
+	entity := azureTextTranslationDBEntity{}
+	if result := r.db.Where("text_hash = ? and from_lang = ? and to_lang = ?", r.hashText(text), r.langString(fromLang), toLang.String()).
+		First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, service.ErrTextTranslationNotFound
+		}
+		return nil, result.Error
+	}
+
+	// guard against hash collisions
+	if entity.Text != text {
+		return nil, service.ErrTextTranslationNotFound
+	}
+
+	return entity.toAzureTextTranslation()
+}
@@ -0,0 +1,83 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+func Test_azureTextTranslationRepository(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from azure_text_translation")
+		require.NoError(t, result.Error)
+
+		// given
+		r := gateway.NewAzureTextTranslationRepository(db)
+		text := "I read a book yesterday, and it was more interesting than I had expected."
+		detected := &service.AzureTextTranslation{
+			FromLang:          domain.Lang2EN,
+			Translated:        "昨日本を読んだが、思っていたより面白かった。",
+			Detected:          true,
+			DetectedLangScore: 0.98,
+		}
+		specified := &service.AzureTextTranslation{
+			FromLang:   domain.Lang2EN,
+			Translated: "昨日本を読みましたが、予想以上に面白かったです。",
+		}
+		require.NoError(t, r.Add(bg, nil, domain.Lang2JA, text, detected), driverName)
+		require.NoError(t, r.Add(bg, domain.Lang2EN, domain.Lang2JA, text, specified), driverName)
+
+		// when
+		got, err := r.Find(bg, nil, domain.Lang2JA, text)
+		// then
+		// - the detected language is returned
+		require.NoError(t, err, driverName)
+		assert.Equal(t, detected.Translated, got.Translated, driverName)
+		assert.Equal(t, "en", got.FromLang.String(), driverName)
+		assert.True(t, got.Detected, driverName)
+		assert.Equal(t, 0.98, got.DetectedLangScore, driverName)
+
+		// when
+		got, err = r.Find(bg, domain.Lang2EN, domain.Lang2JA, text)
+		// then
+		// - the translation of the specified language is cached separately
+		require.NoError(t, err, driverName)
+		assert.Equal(t, specified.Translated, got.Translated, driverName)
+		assert.False(t, got.Detected, driverName)
+
+		// when
+		_, err = r.Find(bg, nil, domain.Lang2EN, text)
+		// then
+		assert.ErrorIs(t, err, service.ErrTextTranslationNotFound, driverName)
+
+		// when
+		err = r.Add(bg, nil, domain.Lang2JA, text, detected)
+		// then
+		assert.ErrorIs(t, err, service.ErrAzureTextTranslationAlreadyExists, driverName)
+
+		// given
+		// - the detected language has no ISO 639-1 code
+		unknown := &service.AzureTextTranslation{
+			FromLang:          domain.Lang2Unknown,
+			Translated:        "おはよう。",
+			Detected:          true,
+			DetectedLangScore: 0.8,
+		}
+		require.NoError(t, r.Add(bg, nil, domain.Lang2JA, "Aloha kakahiaka.", unknown), driverName)
+
+		// when
+		got, err = r.Find(bg, nil, domain.Lang2JA, "Aloha kakahiaka.")
+		// then
+		require.NoError(t, err, driverName)
+		assert.Equal(t, domain.Lang2Unknown, got.FromLang, driverName)
+		assert.True(t, got.Detected, driverName)
+	}
+}
@@ -6,45 +6,225 @@ import (
 	"errors"
 	"regexp"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	libD "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
 	libG "github.com/kujilabo/cocotola-translator-api/src/lib/gateway"
 )
 
+// reverseLookupMaxResults is the maximum number of the translations which a reverse lookup returns from a table
+const reverseLookupMaxResults = 100
+
 type azureTranslationRepository struct {
-	db *gorm.DB
+	db               *gorm.DB
+	negativeCacheTTL time.Duration
+	fromLang         domain.Lang2
 }
 
+// azureTranslationDBEntity is a looked up text. Its translations are stored in azure_translation_candidate.
 type azureTranslationDBEntity struct {
-	Text   string
-	Lang2  string
-	Result string
+	Text        string
+	FromLang2   string
+	Lang2       string
+	CreatedAt   time.Time
+	RefreshedAt time.Time
+	// ExpiresAt is set only for negative entries
+	ExpiresAt *time.Time
 }
 
 func (e *azureTranslationDBEntity) TableName() string {
 	return "azure_translation"
 }
 
-func NewAzureTranslationRepository(db *gorm.DB) service.AzureTranslationRepository {
-	return &azureTranslationRepository{
-		db: db,
+// azureTranslationCandidateDBEntity is a translation of a text. Rank is the order in the result of azure.
+type azureTranslationCandidateDBEntity struct {
+	Text             string
+	FromLang2        string
+	Lang2            string
+	Rank             int
+	Pos              int
+	Target           string
+	Confidence       float64
+	NormalizedSource string
+	PrefixWord       string
+	// BackTranslations is a JSON array of azureBackTranslationJSONEntity
+	BackTranslations string
+}
+
+type azureBackTranslationJSONEntity struct {
+	Text           string `json:"text"`
+	FrequencyCount int    `json:"frequencyCount"`
+}
+
+func (e *azureTranslationCandidateDBEntity) TableName() string {
+	return "azure_translation_candidate"
+}
+
+func (e *azureTranslationCandidateDBEntity) toTranslationCandidate() (service.TranslationCandidate, error) {
+	backTranslationEntities := []azureBackTranslationJSONEntity{}
+	if err := json.Unmarshal([]byte(e.BackTranslations), &backTranslationEntities); err != nil {
+		return service.TranslationCandidate{}, liberrors.Errorf("failed to unmarshal back translations. text: %s, err: %w", e.Text, err)
+	}
+
+	var backTranslations []domain.BackTranslation
+	for _, b := range backTranslationEntities {
+		backTranslations = append(backTranslations, domain.BackTranslation{
+			Text:           b.Text,
+			FrequencyCount: b.FrequencyCount,
+		})
 	}
+
+	return service.TranslationCandidate{
+		Pos:              domain.WordPos(e.Pos),
+		Target:           e.Target,
+		Confidence:       e.Confidence,
+		NormalizedSource: e.NormalizedSource,
+		PrefixWord:       e.PrefixWord,
+		BackTranslations: backTranslations,
+	}, nil
 }
 
-func (r *azureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.AzureTranslation) error {
-	resultBytes, err := json.Marshal(result)
+func (e *azureTranslationCandidateDBEntity) toModel() (domain.Translation, error) {
+	lang2, err := domain.NewLang2(e.Lang2)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := e.toTranslationCandidate()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return t.ToProviderTranslation(service.TranslationProviderAzure, lang2, e.Text)
+}
+
+func toAzureTranslationCandidateDBEntities(fromLang, lang2 domain.Lang2, text string, result []service.TranslationCandidate) ([]azureTranslationCandidateDBEntity, error) {
+	entities := make([]azureTranslationCandidateDBEntity, len(result))
+	for i, r := range result {
+		backTranslationEntities := make([]azureBackTranslationJSONEntity, len(r.BackTranslations))
+		for j, b := range r.BackTranslations {
+			backTranslationEntities[j] = azureBackTranslationJSONEntity{
+				Text:           b.Text,
+				FrequencyCount: b.FrequencyCount,
+			}
+		}
+		backTranslations, err := json.Marshal(backTranslationEntities)
+		if err != nil {
+			return nil, err
+		}
 
+		entities[i] = azureTranslationCandidateDBEntity{
+			Text:             text,
+			FromLang2:        fromLang.String(),
+			Lang2:            lang2.String(),
+			Rank:             i,
+			Pos:              int(r.Pos),
+			Target:           r.Target,
+			Confidence:       r.Confidence,
+			NormalizedSource: r.NormalizedSource,
+			PrefixWord:       r.PrefixWord,
+			BackTranslations: string(backTranslations),
+		}
+	}
+	return entities, nil
+}
+
+// NewAzureTranslationRepository returns the repository of the texts in fromLang. Negative entries are not stored if negativeCacheTTL is zero.
+func NewAzureTranslationRepository(db *gorm.DB, negativeCacheTTL time.Duration, fromLang domain.Lang2) service.AzureTranslationRepository {
+	return &azureTranslationRepository{
+		db:               db,
+		negativeCacheTTL: negativeCacheTTL,
+		fromLang:         fromLang,
+	}
+}
+
+// ofFromLang excludes the texts in the other languages than fromLang
+func (r *azureTranslationRepository) ofFromLang(db *gorm.DB) *gorm.DB {
+	return db.Where("from_lang2 = ?", r.fromLang.String())
+}
+
+// notExpired excludes expired negative entries
+func (r *azureTranslationRepository) notExpired(db *gorm.DB) *gorm.DB {
+	return db.Where("expires_at is null or expires_at > ?", time.Now())
+}
+
+// findCandidates returns the translations of the texts keyed by text in rank order
+func (r *azureTranslationRepository) findCandidates(db *gorm.DB, lang2 domain.Lang2, texts []string) (map[string][]service.TranslationCandidate, error) {
+	results := make(map[string][]service.TranslationCandidate)
+	if len(texts) == 0 {
+		return results, nil
+	}
+
+	entities := []azureTranslationCandidateDBEntity{}
+	if result := db.Scopes(r.ofFromLang).Where("lang2 = ? and text in ?", lang2.String(), texts).
+		Order("text").Order("`rank`").Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, e := range entities {
+		t, err := e.toTranslationCandidate()
+		if err != nil {
+			return nil, err
+		}
+		results[e.Text] = append(results[e.Text], t)
+	}
+	return results, nil
+}
+
+func (r *azureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// translations take the place of the negative entry
+		if result := tx.Scopes(r.ofFromLang).Where("text = ? and lang2 = ? and expires_at is not null", text, lang2.String()).
+			Delete(&azureTranslationDBEntity{}); result.Error != nil {
+			return result.Error
+		}
+
+		entity := azureTranslationDBEntity{
+			Text:        text,
+			FromLang2:   r.fromLang.String(),
+			Lang2:       lang2.String(),
+			RefreshedAt: time.Now(),
+		}
+		if result := tx.Create(&entity); result.Error != nil {
+			return libG.ConvertDuplicatedError(result.Error, service.ErrAzureTranslationAlreadyExists)
+		}
+
+		candidates, err := toAzureTranslationCandidateDBEntities(r.fromLang, lang2, text, result)
+		if err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+		if result := tx.Create(&candidates); result.Error != nil {
+			return libG.ConvertDuplicatedError(result.Error, service.ErrAzureTranslationAlreadyExists)
+		}
+		return nil
+	})
+}
+
+func (r *azureTranslationRepository) AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error {
+	if r.negativeCacheTTL <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if result := r.db.Scopes(r.ofFromLang).Where("text = ? and lang2 = ? and expires_at <= ?", text, lang2.String(), now).
+		Delete(&azureTranslationDBEntity{}); result.Error != nil {
+		return result.Error
+	}
+
+	expiresAt := now.Add(r.negativeCacheTTL)
 	entity := azureTranslationDBEntity{
-		Text:   text,
-		Lang2:  lang2.String(),
-		Result: string(resultBytes),
+		Text:        text,
+		FromLang2:   r.fromLang.String(),
+		Lang2:       lang2.String(),
+		RefreshedAt: now,
+		ExpiresAt:   &expiresAt,
 	}
 
 	if result := r.db.Create(&entity); result.Error != nil {
@@ -54,45 +234,221 @@ func (r *azureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2
 	return nil
 }
 
-func (r *azureTranslationRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.AzureTranslation, error) {
-	entity := azureTranslationDBEntity{}
+func (r *azureTranslationRepository) FindByTarget(ctx context.Context, lang2 domain.Lang2, target string, prefix bool) ([]service.TranslationCacheEntry, error) {
+	db := r.db.Scopes(r.ofFromLang).Where("lang2 = ?", lang2.String())
+	if prefix {
+		db = db.Where("target like ? escape '"+libG.LikeEscape+"'", libG.PrefixLikePattern(target))
+	} else {
+		db = db.Where("target = ?", target)
+	}
 
-	if result := r.db.Where(&azureTranslationDBEntity{
-		Text:  text,
-		Lang2: lang2.String(),
-	}).First(&entity); result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, service.ErrTranslationNotFound
+	// negative entries have no candidates
+	candidates := []azureTranslationCandidateDBEntity{}
+	if result := db.Order("text").Order("`rank`").Limit(reverseLookupMaxResults).Find(&candidates); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]service.TranslationCacheEntry, 0)
+	for _, c := range candidates {
+		t, err := c.toTranslationCandidate()
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 || results[len(results)-1].Text != c.Text {
+			results = append(results, service.TranslationCacheEntry{
+				Lang2: lang2,
+				Text:  c.Text,
+			})
 		}
+		last := &results[len(results)-1]
+		last.Results = append(last.Results, t)
+	}
+	return results, nil
+}
+
+func (r *azureTranslationRepository) FindStale(ctx context.Context, refreshedBefore time.Time, limit int) ([]service.TranslationCacheEntry, error) {
+	if limit <= 0 {
+		return nil, libD.ErrInvalidArgument
+	}
 
+	entities := []azureTranslationDBEntity{}
+	if result := r.db.Scopes(r.ofFromLang).Where("expires_at is null and refreshed_at < ?", refreshedBefore).
+		Order("refreshed_at").Limit(limit).Find(&entities); result.Error != nil {
 		return nil, result.Error
 	}
 
-	result := make([]service.AzureTranslation, 0)
-	if err := json.Unmarshal([]byte(entity.Result), &result); err != nil {
+	results := make([]service.TranslationCacheEntry, len(entities))
+	for i, e := range entities {
+		lang2, err := domain.NewLang2(e.Lang2)
+		if err != nil {
+			return nil, err
+		}
+		candidates, err := r.findCandidates(r.db, lang2, []string{e.Text})
+		if err != nil {
+			return nil, err
+		}
+		results[i] = service.TranslationCacheEntry{
+			Lang2:       lang2,
+			Text:        e.Text,
+			Results:     candidates[e.Text],
+			RefreshedAt: e.RefreshedAt,
+		}
+	}
+	return results, nil
+}
+
+func (r *azureTranslationRepository) Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Model(&azureTranslationDBEntity{}).Scopes(r.ofFromLang).
+			Where("text = ? and lang2 = ? and expires_at is null", text, lang2.String()).
+			Update("refreshed_at", time.Now()); result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return service.ErrTranslationNotFound
+		}
+
+		if result := tx.Scopes(r.ofFromLang).Where("text = ? and lang2 = ?", text, lang2.String()).
+			Delete(&azureTranslationCandidateDBEntity{}); result.Error != nil {
+			return result.Error
+		}
+
+		candidates, err := toAzureTranslationCandidateDBEntities(r.fromLang, lang2, text, result)
+		if err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+		if result := tx.Create(&candidates); result.Error != nil {
+			return result.Error
+		}
+		return nil
+	})
+}
+
+func (r *azureTranslationRepository) Touch(ctx context.Context, lang2 domain.Lang2, text string) error {
+	if result := r.db.Model(&azureTranslationDBEntity{}).Scopes(r.ofFromLang).
+		Where("text = ? and lang2 = ? and expires_at is null", text, lang2.String()).
+		Update("refreshed_at", time.Now()); result.Error != nil {
+		return result.Error
+	} else if result.RowsAffected == 0 {
+		return service.ErrTranslationNotFound
+	}
+	return nil
+}
+
+func (r *azureTranslationRepository) FindNegatives(ctx context.Context, lang2 domain.Lang2, condition *service.TranslationSearchCondition) (*service.NegativeCacheSearchResult, error) {
+	if condition.PageNo <= 0 || condition.PageSize <= 0 {
+		return nil, libD.ErrInvalidArgument
+	}
+
+	limit := condition.PageSize
+	offset := (condition.PageNo - 1) * condition.PageSize
+
+	db := r.db.Scopes(r.ofFromLang).Where("lang2 = ? and expires_at is not null", lang2.String()).Session(&gorm.Session{})
+
+	entities := []azureTranslationDBEntity{}
+	if result := db.Order("text").Limit(limit).Offset(offset).Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	var count int64
+	if result := db.Model(&azureTranslationDBEntity{}).Count(&count); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]service.NegativeCacheEntry, len(entities))
+	for i, e := range entities {
+		results[i] = service.NegativeCacheEntry{
+			Lang2:     lang2,
+			Text:      e.Text,
+			ExpiresAt: *e.ExpiresAt,
+		}
+	}
+
+	return &service.NegativeCacheSearchResult{
+		TotalCount: count,
+		Results:    results,
+	}, nil
+}
+
+func (r *azureTranslationRepository) RemoveNegatives(ctx context.Context, lang2 domain.Lang2, text string) (int64, error) {
+	db := r.db.Scopes(r.ofFromLang).Where("lang2 = ? and expires_at is not null", lang2.String())
+	if text != "" {
+		db = db.Where("text = ?", text)
+	}
+
+	result := db.Delete(&azureTranslationDBEntity{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *azureTranslationRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.TranslationCandidate, error) {
+	contained, err := r.Contain(ctx, lang2, text)
+	if err != nil {
 		return nil, err
 	}
+	if !contained {
+		return nil, service.ErrTranslationNotFound
+	}
 
+	candidates, err := r.findCandidates(r.db, lang2, []string{text})
+	if err != nil {
+		return nil, err
+	}
+
+	result := candidates[text]
+	if result == nil {
+		result = make([]service.TranslationCandidate, 0)
+	}
 	return result, nil
 }
-func (r *azureTranslationRepository) FindByTextAndPos(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error) {
-	results, err := r.Find(ctx, lang2, text)
+
+func (r *azureTranslationRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]service.TranslationCandidate, error) {
+	results := make(map[string][]service.TranslationCandidate)
+	if len(texts) == 0 {
+		return results, nil
+	}
+
+	entities := []azureTranslationDBEntity{}
+	if result := r.db.Scopes(r.ofFromLang, r.notExpired).Where("lang2 = ? and text in ?", lang2.String(), texts).Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	containedTexts := make([]string, len(entities))
+	for i, e := range entities {
+		containedTexts[i] = e.Text
+	}
+
+	candidates, err := r.findCandidates(r.db, lang2, containedTexts)
 	if err != nil {
 		return nil, err
 	}
-	for _, r := range results {
-		if r.Pos != pos {
-			continue
-		}
 
-		t, err := r.ToTranslation(lang2, text)
-		if err != nil {
-			return nil, err
+	for _, text := range containedTexts {
+		results[text] = candidates[text]
+		if results[text] == nil {
+			results[text] = make([]service.TranslationCandidate, 0)
 		}
+	}
+	return results, nil
+}
 
-		return t, nil
+func (r *azureTranslationRepository) FindByTextAndPos(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error) {
+	entity := azureTranslationCandidateDBEntity{}
+	if result := r.db.Scopes(r.ofFromLang).Where(&azureTranslationCandidateDBEntity{
+		Text:  text,
+		Lang2: lang2.String(),
+	}).Where("pos = ?", int(pos)).Order("`rank`").First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, service.ErrTranslationNotFound
+		}
+		return nil, result.Error
 	}
-	return nil, service.ErrTranslationNotFound
+
+	return entity.toModel()
 }
 
 func (r *azureTranslationRepository) FindByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error) {
@@ -103,7 +459,7 @@ func (r *azureTranslationRepository) FindByText(ctx context.Context, lang2 domai
 
 	translations := make([]domain.Translation, 0)
 	for _, r := range azureTranslations {
-		t, err := r.ToTranslation(lang2, text)
+		t, err := r.ToProviderTranslation(service.TranslationProviderAzure, lang2, text)
 		if err != nil {
 			return nil, err
 		}
@@ -128,63 +484,78 @@ func (r *azureTranslationRepository) FindByFirstLetter(ctx context.Context, lang
 	upper := strings.ToUpper(firstLetter) + "%"
 	lower := strings.ToLower(firstLetter) + "%"
 
-	entities := []azureTranslationDBEntity{}
-	if result := r.db.Where(&azureTranslationDBEntity{
+	entities := []azureTranslationCandidateDBEntity{}
+	if result := r.db.Scopes(r.ofFromLang).Where(&azureTranslationCandidateDBEntity{
 		Lang2: lang2.String(),
-	}).Where("text like ? OR text like ?", upper, lower).Find(&entities); result.Error != nil {
+	}).Where("text like ? OR text like ?", upper, lower).
+		Order("text").Order("`rank`").Find(&entities); result.Error != nil {
 		return nil, result.Error
 	}
 
-	results := make([]domain.Translation, 0)
-	for _, e := range entities {
-		result := make([]service.AzureTranslation, 0)
-		if err := json.Unmarshal([]byte(e.Result), &result); err != nil {
+	results := make([]domain.Translation, len(entities))
+	for i, e := range entities {
+		t, err := e.toModel()
+		if err != nil {
 			return nil, err
 		}
-		for _, r := range result {
-			t, err := r.ToTranslation(lang2, e.Text)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, t)
-		}
+		results[i] = t
 	}
 
 	return results, nil
 }
 
-// func (r *azureTranslationRepository) FindTranslations(ctx context.Context, param *domain.AzureTranslationSearchCondition) (*domain.AzureTranslation, error) {
-// 	limit := param.PageSize
-// 	offset := (param.PageNo - 1) * param.PageSize
-// 	var entities []azureTranslationEntity
-// 	if result := r.db.Limit(limit).Offset(offset).Find(&entities); result.Error != nil {
-// 		return nil, result.Error
-// 	}
-
-// 	var count int64
-// 	if result := r.db.Model(azureTranslationEntity{}).Count(&count); result.Error != nil {
-// 		return nil, result.Error
-// 	}
-
-// 	results := make([][]domain.AzureTranslation, len(entities))
-// 	for i, e := range entities {
-// 		result := make([]domain.AzureTranslation, 0)
-// 		if err := json.Unmarshal([]byte(e.Result), &result); err != nil {
-// 			return nil, err
-// 		}
-// 		results[i] = result
-// 	}
-
-// 	return &domain.AzureTranslationSearchResult{
-// 		TotalCount: count,
-// 		Results:    results,
-// 	}, nil
-// }
+func (r *azureTranslationRepository) FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func(texts []string, translations []domain.Translation) error) error {
+	if batchSize <= 0 {
+		return libD.ErrInvalidArgument
+	}
+
+	lastText := ""
+	for {
+		entities := []azureTranslationDBEntity{}
+		// expired negative entries are excluded in the same way as FindByTexts so that their texts are exported as custom only ones
+		if result := r.db.Scopes(r.ofFromLang, r.notExpired).Where("lang2 = ? and text > ?", lang2.String(), lastText).
+			Order("text").Limit(batchSize).Find(&entities); result.Error != nil {
+			return result.Error
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		texts := make([]string, len(entities))
+		for i, e := range entities {
+			texts[i] = e.Text
+		}
+
+		candidates := []azureTranslationCandidateDBEntity{}
+		if result := r.db.Scopes(r.ofFromLang).Where("lang2 = ? and text in ?", lang2.String(), texts).
+			Order("text").Order("`rank`").Find(&candidates); result.Error != nil {
+			return result.Error
+		}
+
+		translations := make([]domain.Translation, len(candidates))
+		for i, c := range candidates {
+			t, err := c.toModel()
+			if err != nil {
+				return err
+			}
+			translations[i] = t
+		}
+
+		if err := fn(texts, translations); err != nil {
+			return err
+		}
+
+		if len(entities) < batchSize {
+			return nil
+		}
+		lastText = entities[len(entities)-1].Text
+	}
+}
 
 func (r *azureTranslationRepository) Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error) {
 	entity := azureTranslationDBEntity{}
 
-	if result := r.db.Where(&azureTranslationDBEntity{
+	if result := r.db.Scopes(r.ofFromLang, r.notExpired).Where(&azureTranslationDBEntity{
 		Text:  text,
 		Lang2: lang2.String(),
 	}).First(&entity); result.Error != nil {
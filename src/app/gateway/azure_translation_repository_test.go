@@ -0,0 +1,224 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+func Test_azureTranslationRepository(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		for _, table := range []string{"azure_translation_candidate", "azure_translation"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			require.NoError(t, result.Error)
+		}
+
+		// given
+		r := gateway.NewAzureTranslationRepository(db, time.Hour, domain.Lang2EN)
+		bookResults := []service.TranslationCandidate{
+			{
+				Pos:              domain.PosNoun,
+				Target:           "本",
+				Confidence:       0.9,
+				NormalizedSource: "book",
+				BackTranslations: []domain.BackTranslation{
+					{Text: "book", FrequencyCount: 7203},
+					{Text: "books", FrequencyCount: 1211},
+				},
+			},
+			{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.1, NormalizedSource: "book"},
+		}
+		require.NoError(t, r.Add(bg, domain.Lang2JA, "book", bookResults), driverName)
+		require.NoError(t, r.AddNegative(bg, domain.Lang2JA, "bokk"), driverName)
+
+		// when
+		got, err := r.Find(bg, domain.Lang2JA, "book")
+		// then
+		// - candidates are returned in rank order
+		require.NoError(t, err, driverName)
+		assert.Equal(t, bookResults, got, driverName)
+
+		// when
+		got, err = r.Find(bg, domain.Lang2JA, "bokk")
+		// then
+		// - a negative entry has no candidates
+		require.NoError(t, err, driverName)
+		assert.Equal(t, 0, len(got), driverName)
+
+		// when
+		translation, err := r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosVerb)
+		// then
+		require.NoError(t, err, driverName)
+		assert.Equal(t, "予約する", translation.GetTranslated(), driverName)
+		assert.Equal(t, 0.1, translation.GetConfidence(), driverName)
+		assert.Equal(t, "book", translation.GetNormalizedSource(), driverName)
+
+		// when
+		_, err = r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosAdj)
+		// then
+		assert.ErrorIs(t, err, service.ErrTranslationNotFound, driverName)
+
+		// when
+		gotMap, err := r.FindByTexts(bg, domain.Lang2JA, []string{"book", "bokk", "pen"})
+		// then
+		require.NoError(t, err, driverName)
+		assert.Equal(t, map[string][]service.TranslationCandidate{
+			"book": bookResults,
+			"bokk": {},
+		}, gotMap, driverName)
+
+		// when
+		refreshed := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "書籍", Confidence: 1}}
+		require.NoError(t, r.Refresh(bg, domain.Lang2JA, "book", refreshed), driverName)
+		got, err = r.Find(bg, domain.Lang2JA, "book")
+		// then
+		// - the candidates are replaced
+		require.NoError(t, err, driverName)
+		assert.Equal(t, refreshed, got, driverName)
+
+		// when
+		err = r.Touch(bg, domain.Lang2JA, "book")
+		// then
+		assert.NoError(t, err, driverName)
+
+		// when
+		err = r.Touch(bg, domain.Lang2JA, "bokk")
+		// then
+		// - negative entries are not refreshed
+		assert.ErrorIs(t, err, service.ErrTranslationNotFound, driverName)
+	}
+}
+
+func Test_azureTranslationRepository_FindByLang2InBatches(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		for _, table := range []string{"azure_translation_candidate", "azure_translation"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			require.NoError(t, result.Error)
+		}
+
+		// given
+		// - "book" has a translation, "bokk" is negative and the negative entry of "pen" has expired
+		r := gateway.NewAzureTranslationRepository(db, time.Hour, domain.Lang2EN)
+		require.NoError(t, r.Add(bg, domain.Lang2JA, "book", []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 1}}), driverName)
+		require.NoError(t, r.AddNegative(bg, domain.Lang2JA, "bokk"), driverName)
+		require.NoError(t, r.AddNegative(bg, domain.Lang2JA, "pen"), driverName)
+		result := db.Exec("update azure_translation set expires_at = ? where text = ?", time.Now().Add(-time.Minute), "pen")
+		require.NoError(t, result.Error, driverName)
+
+		// when
+		gotTexts := make([]string, 0)
+		gotTranslated := make([]string, 0)
+		err := r.FindByLang2InBatches(bg, domain.Lang2JA, 1, func(texts []string, translations []domain.Translation) error {
+			gotTexts = append(gotTexts, texts...)
+			for _, t := range translations {
+				gotTranslated = append(gotTranslated, t.GetTranslated())
+			}
+			return nil
+		})
+
+		// then
+		// - "pen" is not contained as FindByTexts does not contain it, so that the export writes it only once as a custom only text
+		require.NoError(t, err, driverName)
+		assert.Equal(t, []string{"bokk", "book"}, gotTexts, driverName)
+		assert.Equal(t, []string{"本"}, gotTranslated, driverName)
+		gotMap, err := r.FindByTexts(bg, domain.Lang2JA, []string{"pen"})
+		require.NoError(t, err, driverName)
+		assert.Empty(t, gotMap, driverName)
+	}
+}
+
+func Test_azureTranslationRepository_FindByTarget(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		for _, table := range []string{"azure_translation_candidate", "azure_translation"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			require.NoError(t, result.Error)
+		}
+
+		// given
+		r := gateway.NewAzureTranslationRepository(db, time.Hour, domain.Lang2EN)
+		require.NoError(t, r.Add(bg, domain.Lang2JA, "book", []service.TranslationCandidate{
+			{Pos: domain.PosNoun, Target: "本", Confidence: 0.9},
+			{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.1},
+		}), driverName)
+		require.NoError(t, r.Add(bg, domain.Lang2JA, "reserve", []service.TranslationCandidate{
+			{Pos: domain.PosVerb, Target: "予約", Confidence: 0.4},
+			{Pos: domain.PosNoun, Target: "予備", Confidence: 0.3},
+		}), driverName)
+		require.NoError(t, r.Add(bg, domain.Lang2JA, "percent", []service.TranslationCandidate{
+			{Pos: domain.PosNoun, Target: "100%", Confidence: 1},
+		}), driverName)
+
+		// when
+		got, err := r.FindByTarget(bg, domain.Lang2JA, "予約", false)
+		// then
+		// - only the exactly matched translation is returned
+		require.NoError(t, err, driverName)
+		require.Equal(t, 1, len(got), driverName)
+		assert.Equal(t, "reserve", got[0].Text, driverName)
+		assert.Equal(t, []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "予約", Confidence: 0.4}}, got[0].Results, driverName)
+
+		// when
+		got, err = r.FindByTarget(bg, domain.Lang2JA, "予約", true)
+		// then
+		// - the translations starting with the target are returned in text order
+		require.NoError(t, err, driverName)
+		require.Equal(t, 2, len(got), driverName)
+		assert.Equal(t, "book", got[0].Text, driverName)
+		assert.Equal(t, "予約する", got[0].Results[0].Target, driverName)
+		assert.Equal(t, "reserve", got[1].Text, driverName)
+
+		// when
+		got, err = r.FindByTarget(bg, domain.Lang2JA, "10%", true)
+		// then
+		// - wildcards in the target are not special
+		require.NoError(t, err, driverName)
+		assert.Equal(t, 0, len(got), driverName)
+	}
+}
+
+func Test_azureTranslationRepository_fromLang(t *testing.T) {
+	bg := context.Background()
+	lang2DE, err := domain.NewLang2("de")
+	require.NoError(t, err)
+	for driverName, db := range dbList() {
+		for _, table := range []string{"azure_translation_candidate", "azure_translation"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			require.NoError(t, result.Error)
+		}
+
+		// given
+		// - "die" is cached in english and german
+		enRepo := gateway.NewAzureTranslationRepository(db, time.Hour, domain.Lang2EN)
+		deRepo := gateway.NewAzureTranslationRepository(db, time.Hour, lang2DE)
+		require.NoError(t, enRepo.Add(bg, domain.Lang2JA, "die", []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "死ぬ", Confidence: 1}}), driverName)
+		require.NoError(t, deRepo.Add(bg, domain.Lang2JA, "die", []service.TranslationCandidate{{Pos: domain.PosOther, Target: "その", Confidence: 1}}), driverName)
+		require.NoError(t, deRepo.AddNegative(bg, domain.Lang2JA, "book"), driverName)
+
+		// when
+		enGot, err := enRepo.Find(bg, domain.Lang2JA, "die")
+		require.NoError(t, err, driverName)
+		deGot, err := deRepo.Find(bg, domain.Lang2JA, "die")
+		require.NoError(t, err, driverName)
+		enContained, err := enRepo.Contain(bg, domain.Lang2JA, "book")
+		require.NoError(t, err, driverName)
+
+		// then
+		// - the rows of the source languages are not shared
+		require.Equal(t, 1, len(enGot), driverName)
+		assert.Equal(t, "死ぬ", enGot[0].Target, driverName)
+		require.Equal(t, 1, len(deGot), driverName)
+		assert.Equal(t, "その", deGot[0].Target, driverName)
+		assert.False(t, enContained, driverName)
+	}
+}
@@ -2,18 +2,56 @@ package gateway
 
 import (
 	"context"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/v3.0/translatortext"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
 	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
 )
 
+const AzureTranslatorDefaultEndpoint = "https://api.cognitive.microsofttranslator.com"
+
+// azureDictionaryLookupMaxTexts is the maximum number of texts which dictionary/lookup accepts
+const azureDictionaryLookupMaxTexts = 10
+
+// azureDictionaryLangs are the languages which dictionary/lookup and dictionary/examples translate english texts into and from.
+// "sr" is not contained because the API requires its script and has only one of them.
+var azureDictionaryLangs = []string{
+	"af", "ar", "bg", "bn", "bs", "ca", "cs", "cy", "da", "de", "el", "es", "et", "fa", "fi", "fj",
+	"fr", "he", "hi", "hr", "ht", "hu", "id", "is", "it", "ja", "ko", "lt", "lv", "mg", "ms", "mt",
+	"nb", "nl", "pl", "pt", "ro", "ru", "sk", "sl", "sm", "sv", "sw", "ta", "te", "th", "to", "tr",
+	"ty", "uk", "ur", "vi", "zh",
+}
+
+// azureTextLangs are the languages which translate translates texts between. They are the ones which have ISO 639-1 codes.
+var azureTextLangs = []string{
+	"af", "am", "ar", "as", "az", "ba", "bg", "bn", "bo", "bs", "ca", "cs", "cy", "da", "de", "dv",
+	"el", "en", "es", "et", "eu", "fa", "fi", "fj", "fo", "fr", "ga", "gl", "gu", "ha", "he", "hi",
+	"hr", "ht", "hu", "hy", "id", "ig", "ik", "is", "it", "iu", "ja", "ka", "kk", "km", "kn", "ko",
+	"ku", "ky", "lo", "lt", "lv", "mg", "mi", "mk", "ml", "mn", "mr", "ms", "mt", "my", "nb", "ne",
+	"nl", "ny", "or", "pa", "pl", "ps", "pt", "ro", "ru", "rw", "sd", "si", "sk", "sl", "sm", "sn",
+	"so", "sq", "st", "sv", "sw", "ta", "te", "th", "ti", "tk", "tl", "to", "tr", "tt", "ty", "ug",
+	"uk", "ur", "uz", "vi", "xh", "yo", "zh", "zu",
+}
+
+// azureLangCodes are the codes of the API which are not the same as ISO 639-1
+var azureLangCodes = map[string]string{
+	"zh": "zh-Hans",
+}
+
 type azureTranslationClient struct {
-	client translatortext.TranslatorClient
+	client    translatortext.TranslatorClient
+	timeout   time.Duration
+	pairs     []domain.LanguagePair
+	textPairs []domain.LanguagePair
 }
 
 type AzureDisplayTranslation struct {
@@ -22,50 +60,300 @@ type AzureDisplayTranslation struct {
 	Confidence float64
 }
 
-func NewAzureTranslationClient(subscriptionKey string) service.AzureTranslationClient {
-	client := translatortext.NewTranslatorClient("https://api.cognitive.microsofttranslator.com")
+// NewAzureTranslationClient returns the client of the Azure Translator API.
+// The default endpoint is used if endpoint is empty. region is required for regional or multi-service resources. timeout is disabled if it is zero.
+func NewAzureTranslationClient(endpoint, region, subscriptionKey string, timeout time.Duration) service.AzureTranslationClient {
+	if endpoint == "" {
+		endpoint = AzureTranslatorDefaultEndpoint
+	}
+
+	client := translatortext.NewTranslatorClient(endpoint)
 	client.Authorizer = autorest.NewCognitiveServicesAuthorizer(subscriptionKey)
+	if region != "" {
+		client.RequestInspector = autorest.WithHeader("Ocp-Apim-Subscription-Region", region)
+	}
 	return &azureTranslationClient{
-		client: client,
+		client:    client,
+		timeout:   timeout,
+		pairs:     newAzureDictionaryLanguagePairs(),
+		textPairs: newAzureTextLanguagePairs(),
+	}
+}
+
+// newAzureDictionaryLanguagePairs returns the pairs from and to english because the dictionary translates only between english and the other languages
+func newAzureDictionaryLanguagePairs() []domain.LanguagePair {
+	pairs := make([]domain.LanguagePair, 0, len(azureDictionaryLangs)*2)
+	for _, lang := range azureDictionaryLangs {
+		for _, p := range [][2]string{{domain.Lang2EN.String(), lang}, {lang, domain.Lang2EN.String()}} {
+			pair, err := domain.NewLanguagePair(p[0], p[1])
+			if err != nil {
+				panic(err)
+			}
+			pairs = append(pairs, pair)
+		}
 	}
+	return pairs
+}
 
+// newAzureTextLanguagePairs returns the pairs of all the different languages of azureTextLangs
+func newAzureTextLanguagePairs() []domain.LanguagePair {
+	pairs := make([]domain.LanguagePair, 0, len(azureTextLangs)*(len(azureTextLangs)-1))
+	for _, fromLang := range azureTextLangs {
+		for _, toLang := range azureTextLangs {
+			if fromLang == toLang {
+				continue
+			}
+			pair, err := domain.NewLanguagePair(fromLang, toLang)
+			if err != nil {
+				panic(err)
+			}
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
 }
 
-func (c *azureTranslationClient) DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]service.AzureTranslation, error) {
-	logger := log.FromContext(ctx)
+func (c *azureTranslationClient) SupportedLanguagePairs() []domain.LanguagePair {
+	return c.pairs
+}
+
+func (c *azureTranslationClient) SupportedTextLanguagePairs() []domain.LanguagePair {
+	return c.textPairs
+}
+
+// toAzureLangCode returns the code of the API such as "zh-Hans" for "zh"
+func (c *azureTranslationClient) toAzureLangCode(lang domain.Lang2) string {
+	if code, ok := azureLangCodes[lang.String()]; ok {
+		return code
+	}
+	return lang.String()
+}
+
+func (c *azureTranslationClient) DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]service.TranslationCandidate, error) {
 	ctx, span := tracer.Start(ctx, "azureTranslationClient.DictionaryLookup")
 	defer span.End()
 
-	result, err := c.client.DictionaryLookup(ctx, fromLang.String(), toLang.String(), []translatortext.DictionaryLookupTextInput{{Text: to.StringPtr(text)}}, "")
+	results, err := c.dictionaryLookup(ctx, []string{text}, fromLang, toLang)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// DictionaryLookupBatch sends azureDictionaryLookupMaxTexts texts at a time.
+func (c *azureTranslationClient) DictionaryLookupBatch(ctx context.Context, texts []string, fromLang, toLang domain.Lang2) (map[string][]service.TranslationCandidate, error) {
+	ctx, span := tracer.Start(ctx, "azureTranslationClient.DictionaryLookupBatch")
+	defer span.End()
+
+	resultMap := make(map[string][]service.TranslationCandidate)
+	for start := 0; start < len(texts); start += azureDictionaryLookupMaxTexts {
+		end := start + azureDictionaryLookupMaxTexts
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		results, err := c.dictionaryLookup(ctx, texts[start:end], fromLang, toLang)
+		if err != nil {
+			return nil, err
+		}
+		for i, text := range texts[start:end] {
+			resultMap[text] = results[i]
+		}
+	}
+	return resultMap, nil
+}
+
+// dictionaryLookup returns the translations in the order of texts
+func (c *azureTranslationClient) dictionaryLookup(ctx context.Context, texts []string, fromLang, toLang domain.Lang2) ([][]service.TranslationCandidate, error) {
+	logger := log.FromContext(ctx)
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	inputs := make([]translatortext.DictionaryLookupTextInput, len(texts))
+	for i, text := range texts {
+		inputs[i] = translatortext.DictionaryLookupTextInput{Text: to.StringPtr(text)}
+	}
+
+	result, err := c.client.DictionaryLookup(ctx, c.toAzureLangCode(fromLang), c.toAzureLangCode(toLang), inputs, "")
 	if err != nil {
 		return nil, err
 	}
+
+	results := make([][]service.TranslationCandidate, len(texts))
 	if result.Value == nil {
-		return nil, nil
+		return results, nil
+	}
+	if len(*result.Value) != len(texts) {
+		return nil, liberrors.Errorf("unexpected number of dictionary lookup results. expected: %d, actual: %d", len(texts), len(*result.Value))
 	}
 
-	translations := make([]service.AzureTranslation, 0)
-	for _, v := range *result.Value {
-		if v.Translations == nil {
-			continue
+	for i, v := range *result.Value {
+		translations := make([]service.TranslationCandidate, 0)
+		if v.Translations != nil {
+			for _, t := range *v.Translations {
+				pos, err := domain.ParsePos(c.pointerToString(t.PosTag))
+				if err != nil {
+					return nil, err
+				}
+				if pos == domain.PosOther {
+					logger.Warnf("PosOther. text: %s, pos: %s", texts[i], c.pointerToString(t.PosTag))
+				}
+				translations = append(translations, service.TranslationCandidate{
+					Pos:              pos,
+					Target:           c.pointerToString(t.DisplayTarget),
+					Confidence:       c.pointerToFloat64(t.Confidence),
+					NormalizedSource: c.pointerToString(v.NormalizedSource),
+					PrefixWord:       c.pointerToString(t.PrefixWord),
+					BackTranslations: c.toBackTranslations(t.BackTranslations),
+				})
+			}
 		}
+		results[i] = translations
+	}
+	return results, nil
+}
 
-		for _, t := range *v.Translations {
-			pos, err := domain.ParsePos(c.pointerToString(t.PosTag))
-			if err != nil {
-				return nil, err
-			}
-			if pos == domain.PosOther {
-				logger.Warnf("PosOther. text: %s, pos: %s", text, *t.PosTag)
-			}
-			translations = append(translations, service.AzureTranslation{
-				Pos:        pos,
-				Target:     c.pointerToString(t.DisplayTarget),
-				Confidence: c.pointerToFloat64(t.Confidence),
-			})
+func (c *azureTranslationClient) Examples(ctx context.Context, text, translated string, fromLang, toLang domain.Lang2) ([]service.AzureExample, error) {
+	ctx, span := tracer.Start(ctx, "azureTranslationClient.Examples")
+	defer span.End()
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	inputs := []translatortext.DictionaryExampleTextInput{{
+		Text:        to.StringPtr(text),
+		Translation: to.StringPtr(translated),
+	}}
+
+	result, err := c.client.DictionaryExamples(ctx, c.toAzureLangCode(fromLang), c.toAzureLangCode(toLang), inputs, "")
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make([]service.AzureExample, 0)
+	if result.Value == nil || len(*result.Value) == 0 || (*result.Value)[0].Examples == nil {
+		return examples, nil
+	}
+
+	for _, e := range *(*result.Value)[0].Examples {
+		examples = append(examples, service.AzureExample{
+			Source: domain.ExampleSentence{
+				Prefix: c.pointerToString(e.SourcePrefix),
+				Term:   c.pointerToString(e.SourceTerm),
+				Suffix: c.pointerToString(e.SourceSuffix),
+			},
+			Target: domain.ExampleSentence{
+				Prefix: c.pointerToString(e.TargetPrefix),
+				Term:   c.pointerToString(e.TargetTerm),
+				Suffix: c.pointerToString(e.TargetSuffix),
+			},
+		})
+	}
+	return examples, nil
+}
+
+// azureTranslateResultItem is an item of the response of translate.
+// translatortext.TranslateResultAllItem is not used because its score of the detected language is an integer while the API returns a float between 0 and 1.
+type azureTranslateResultItem struct {
+	DetectedLanguage *struct {
+		Language string  `json:"language"`
+		Score    float64 `json:"score"`
+	} `json:"detectedLanguage"`
+	Translations []struct {
+		Text string `json:"text"`
+		To   string `json:"to"`
+	} `json:"translations"`
+}
+
+func (c *azureTranslationClient) Translate(ctx context.Context, text string, fromLang, toLang domain.Lang2) (*service.AzureTextTranslation, error) {
+	ctx, span := tracer.Start(ctx, "azureTranslationClient.Translate")
+	defer span.End()
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	from := ""
+	if fromLang != nil {
+		from = c.toAzureLangCode(fromLang)
+	}
+
+	inputs := []translatortext.TranslateTextInput{{Text: to.StringPtr(text)}}
+	req, err := c.client.TranslatePreparer(ctx, []string{c.toAzureLangCode(toLang)}, inputs, from, "", "", "", "", nil, nil, "", "", nil, "")
+	if err != nil {
+		return nil, liberrors.Errorf("failed to TranslatePreparer. err: %w", err)
+	}
+	resp, err := c.client.TranslateSender(req)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to TranslateSender. err: %w", err)
+	}
+
+	items := []azureTranslateResultItem{}
+	if err := autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&items),
+		autorest.ByClosing()); err != nil {
+		return nil, err
+	}
+	if len(items) != 1 || len(items[0].Translations) != 1 {
+		return nil, liberrors.Errorf("unexpected translate result. text: %s", text)
+	}
+
+	result := &service.AzureTextTranslation{
+		FromLang:   fromLang,
+		Translated: items[0].Translations[0].Text,
+	}
+	if fromLang == nil {
+		if items[0].DetectedLanguage == nil {
+			return nil, liberrors.Errorf("detected language is missing. text: %s", text)
+		}
+		result.FromLang = c.toDetectedLang(ctx, items[0].DetectedLanguage.Language)
+		result.Detected = true
+		result.DetectedLangScore = items[0].DetectedLanguage.Score
+	}
+	return result, nil
+}
+
+// toDetectedLang returns the language of the code such as "zh-Hans" detected by the API.
+// It returns Lang2Unknown if the language has no ISO 639-1 code such as "yue" or "fil", because the text has been translated anyway.
+func (c *azureTranslationClient) toDetectedLang(ctx context.Context, code string) domain.Lang2 {
+	logger := log.FromContext(ctx)
+
+	// the detected language may have a script subtag such as zh-Hans
+	detectedLang, err := domain.NewLang2(strings.SplitN(code, "-", 2)[0])
+	if err != nil {
+		logger.Infof("detected language has no ISO 639-1 code. language: %s", code)
+		return domain.Lang2Unknown
+	}
+	return detectedLang
+}
+
+func (c *azureTranslationClient) toBackTranslations(items *[]translatortext.DictionaryLookupResultItemTranslationsItemBackTranslationsItem) []domain.BackTranslation {
+	if items == nil || len(*items) == 0 {
+		return nil
+	}
+
+	backTranslations := make([]domain.BackTranslation, 0, len(*items))
+	for _, item := range *items {
+		frequencyCount := 0
+		if item.FrequencyCount != nil {
+			frequencyCount = int(*item.FrequencyCount)
 		}
+		backTranslations = append(backTranslations, domain.BackTranslation{
+			Text:           c.pointerToString(item.DisplayText),
+			FrequencyCount: frequencyCount,
+		})
 	}
-	return translations, nil
+	return backTranslations
 }
 
 func (c *azureTranslationClient) pointerToString(value *string) string {
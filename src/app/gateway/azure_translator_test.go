@@ -1,11 +1,214 @@
 package gateway_test
 
 import (
+	"context"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway/fake"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 )
 
-func Test_a(t *testing.T) {
+func Test_azureTranslationClient_DictionaryLookup(t *testing.T) {
+	bg := context.Background()
+
+	dictionary, err := fake.DefaultAzureDictionary()
+	require.NoError(t, err)
+	server := httptest.NewServer(fake.NewAzureTranslatorHandler("KEY", "japaneast", dictionary))
+	defer server.Close()
+
+	tests := []struct {
+		name            string
+		subscriptionKey string
+		region          string
+		text            string
+		want            []service.TranslationCandidate
+		wantErr         bool
+	}{
+		{
+			name:            "found",
+			subscriptionKey: "KEY",
+			region:          "japaneast",
+			text:            "beautiful",
+			want: []service.TranslationCandidate{
+				{
+					Pos:              domain.PosAdj,
+					Target:           "美しい",
+					Confidence:       0.8,
+					NormalizedSource: "beautiful",
+					BackTranslations: []domain.BackTranslation{
+						{Text: "beautiful", FrequencyCount: 3124},
+						{Text: "lovely", FrequencyCount: 212},
+					},
+				},
+			},
+		},
+		{
+			name:            "not found",
+			subscriptionKey: "KEY",
+			region:          "japaneast",
+			text:            "pen",
+			want:            []service.TranslationCandidate{},
+		},
+		{
+			name:            "invalid subscription key",
+			subscriptionKey: "INVALID",
+			region:          "japaneast",
+			text:            "beautiful",
+			wantErr:         true,
+		},
+		{
+			name:            "invalid region",
+			subscriptionKey: "KEY",
+			region:          "eastus",
+			text:            "beautiful",
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			client := gateway.NewAzureTranslationClient(server.URL, tt.region, tt.subscriptionKey, time.Second)
+			// when
+			got, err := client.DictionaryLookup(bg, tt.text, domain.Lang2EN, domain.Lang2JA)
+			// then
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_azureTranslationClient_DictionaryLookupBatch(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	dictionary, err := fake.DefaultAzureDictionary()
+	require.NoError(t, err)
+	server := httptest.NewServer(fake.NewAzureTranslatorHandler("KEY", "", dictionary))
+	defer server.Close()
+	client := gateway.NewAzureTranslationClient(server.URL, "", "KEY", time.Second)
+	// - more texts than dictionary/lookup accepts at once
+	texts := []string{"book", "run", "beautiful"}
+	for i := 0; i < 10; i++ {
+		texts = append(texts, "unknown"+strconv.Itoa(i))
+	}
+
+	// when
+	got, err := client.DictionaryLookupBatch(bg, texts, domain.Lang2EN, domain.Lang2JA)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, len(texts), len(got))
+	assert.Equal(t, 3, len(got["book"]))
+	assert.Equal(t, "走る", got["run"][0].Target)
+	assert.Equal(t, "美しい", got["beautiful"][0].Target)
+	assert.Equal(t, 0, len(got["unknown9"]))
+}
+
+func Test_azureTranslationClient_DictionaryLookup_chinese(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	dictionary, err := fake.DefaultAzureDictionary()
+	require.NoError(t, err)
+	server := httptest.NewServer(fake.NewAzureTranslatorHandler("KEY", "", dictionary))
+	defer server.Close()
+	client := gateway.NewAzureTranslationClient(server.URL, "", "KEY", time.Second)
+	zh, err := domain.NewLang2("zh")
+	require.NoError(t, err)
+
+	// when
+	got, err := client.DictionaryLookup(bg, "book", domain.Lang2EN, zh)
+
+	// then
+	// - "zh" is sent as "zh-Hans"
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, "书", got[0].Target)
+	// - the dictionary translates between english and chinese
+	assert.True(t, domain.ContainsLanguagePair(client.SupportedLanguagePairs(), domain.Lang2EN, zh))
+	assert.True(t, domain.ContainsLanguagePair(client.SupportedLanguagePairs(), zh, domain.Lang2EN))
+	assert.False(t, domain.ContainsLanguagePair(client.SupportedLanguagePairs(), zh, domain.Lang2JA))
+	// - texts can be translated from chinese into any language while the dictionary translates only from and to english
+	assert.True(t, domain.ContainsLanguagePair(client.SupportedTextLanguagePairs(), zh, domain.Lang2JA))
+	assert.False(t, domain.ContainsLanguagePair(client.SupportedTextLanguagePairs(), zh, zh))
+}
+
+func Test_azureTranslationClient_Examples(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	dictionary, err := fake.DefaultAzureDictionary()
+	require.NoError(t, err)
+	server := httptest.NewServer(fake.NewAzureTranslatorHandler("KEY", "", dictionary))
+	defer server.Close()
+	client := gateway.NewAzureTranslationClient(server.URL, "", "KEY", time.Second)
+
+	// when
+	got, err := client.Examples(bg, "book", "書籍", domain.Lang2EN, domain.Lang2JA)
+	// then
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, "book", got[0].Source.Term)
+	assert.Equal(t, "書籍", got[0].Target.Term)
+
+	// when
+	got, err = client.Examples(bg, "book", "走る", domain.Lang2EN, domain.Lang2JA)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+}
+
+func Test_azureTranslationClient_Translate(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	dictionary, err := fake.DefaultAzureDictionary()
+	require.NoError(t, err)
+	server := httptest.NewServer(fake.NewAzureTranslatorHandler("KEY", "", dictionary))
+	defer server.Close()
+	client := gateway.NewAzureTranslationClient(server.URL, "", "KEY", time.Second)
+
+	// when
+	got, err := client.Translate(bg, "本を読んだ。", nil, domain.Lang2EN)
+	// then
+	// - the language is detected
+	require.NoError(t, err)
+	assert.Equal(t, "[en] 本を読んだ。", got.Translated)
+	assert.Equal(t, "ja", got.FromLang.String())
+	assert.True(t, got.Detected)
+	assert.Equal(t, 1.0, got.DetectedLangScore)
+
+	// when
+	got, err = client.Translate(bg, "I read a book.", domain.Lang2EN, domain.Lang2JA)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "[ja] I read a book.", got.Translated)
+	assert.Equal(t, domain.Lang2EN, got.FromLang)
+	assert.False(t, got.Detected)
 
+	// when
+	got, err = client.Translate(bg, "Aloha kakahiaka.", nil, domain.Lang2JA)
+	// then
+	// - the detected language which has no ISO 639-1 code is unknown
+	require.NoError(t, err)
+	assert.Equal(t, "[ja] Aloha kakahiaka.", got.Translated)
+	assert.Equal(t, domain.Lang2Unknown, got.FromLang)
+	assert.Equal(t, "", got.FromLang.String())
+	assert.True(t, got.Detected)
+	assert.Equal(t, 0.8, got.DetectedLangScore)
 }
 
 // "context"
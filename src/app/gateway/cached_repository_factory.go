@@ -0,0 +1,337 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/cache"
+)
+
+var repositoryCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "translation_repository_cache_requests_total",
+	Help: "The number of lookups of the in-memory translation repository cache",
+}, []string{"repository", "result"})
+
+// RepositoryCache holds the entries shared by the repositories of CachedRepositoryFactories.
+type RepositoryCache struct {
+	custom   cache.LRUCache
+	provider cache.LRUCache
+}
+
+// NewRepositoryCache returns the cache which holds at most size entries for ttl for each of custom translations and provider translations
+func NewRepositoryCache(size int, ttl time.Duration) *RepositoryCache {
+	return &RepositoryCache{
+		custom:   cache.NewLRUCache(size, ttl),
+		provider: cache.NewLRUCache(size, ttl),
+	}
+}
+
+type cachedRepositoryFactory struct {
+	rf          service.RepositoryFactory
+	cache       *RepositoryCache
+	afterCommit func(hook func())
+}
+
+// NewCachedRepositoryFactory returns the RepositoryFactory whose repositories look up translations in the cache before the repositories of rf.
+// Writes through the repositories invalidate the affected entries. In a transaction of TransactionManager, they are invalidated after the transaction ends.
+func NewCachedRepositoryFactory(ctx context.Context, rf service.RepositoryFactory, cache *RepositoryCache) (service.RepositoryFactory, error) {
+	return &cachedRepositoryFactory{
+		rf:          rf,
+		cache:       cache,
+		afterCommit: afterCommit(ctx),
+	}, nil
+}
+
+func (f *cachedRepositoryFactory) NewAzureTranslationRepository(ctx context.Context) service.AzureTranslationRepository {
+	repo := f.rf.NewAzureTranslationRepository(ctx)
+	return &cachedAzureTranslationRepository{
+		AzureTranslationRepository: repo,
+		cached:                     newCachedTranslationCacheRepository(service.TranslationProviderAzure, domain.Lang2EN, repo, f.cache.provider, f.afterCommit),
+	}
+}
+
+func (f *cachedRepositoryFactory) NewCustomTranslationRepository(ctx context.Context) service.CustomTranslationRepository {
+	return &cachedCustomTranslationRepository{
+		CustomTranslationRepository: f.rf.NewCustomTranslationRepository(ctx),
+		cache:                       f.cache.custom,
+		afterCommit:                 f.afterCommit,
+	}
+}
+
+// NewAzureExampleRepository returns the repository of rf. Examples are looked up much less often than translations.
+func (f *cachedRepositoryFactory) NewAzureExampleRepository(ctx context.Context) service.AzureExampleRepository {
+	return f.rf.NewAzureExampleRepository(ctx)
+}
+
+// NewAzureTextTranslationRepository returns the repository of rf. Free texts rarely repeat in a short time.
+func (f *cachedRepositoryFactory) NewAzureTextTranslationRepository(ctx context.Context) service.AzureTextTranslationRepository {
+	return f.rf.NewAzureTextTranslationRepository(ctx)
+}
+
+func (f *cachedRepositoryFactory) NewTranslationCacheRepository(ctx context.Context, providerName string, fromLang domain.Lang2) (service.TranslationCacheRepository, error) {
+	repo, err := f.rf.NewTranslationCacheRepository(ctx, providerName, fromLang)
+	if err != nil {
+		return nil, err
+	}
+	return newCachedTranslationCacheRepository(providerName, fromLang, repo, f.cache.provider, f.afterCommit), nil
+}
+
+func countRepositoryCache(repository string, hit bool) {
+	if hit {
+		repositoryCacheRequests.WithLabelValues(repository, "hit").Inc()
+	} else {
+		repositoryCacheRequests.WithLabelValues(repository, "miss").Inc()
+	}
+}
+
+// cachedTranslationCacheRepository caches whether the text is contained and its translations with one entry.
+type cachedTranslationCacheRepository struct {
+	providerName string
+	fromLang     domain.Lang2
+	repo         service.TranslationCacheRepository
+	cache        cache.LRUCache
+	afterCommit  func(hook func())
+}
+
+type providerCacheEntry struct {
+	contained bool
+	results   []service.TranslationCandidate
+}
+
+func newCachedTranslationCacheRepository(providerName string, fromLang domain.Lang2, repo service.TranslationCacheRepository, cache cache.LRUCache, afterCommit func(hook func())) *cachedTranslationCacheRepository {
+	return &cachedTranslationCacheRepository{
+		providerName: providerName,
+		fromLang:     fromLang,
+		repo:         repo,
+		cache:        cache,
+		afterCommit:  afterCommit,
+	}
+}
+
+func (r *cachedTranslationCacheRepository) key(lang2 domain.Lang2, text string) string {
+	return r.providerName + "\x00" + r.fromLang.String() + "\x00" + lang2.String() + "\x00" + text
+}
+
+func (r *cachedTranslationCacheRepository) invalidate(lang2 domain.Lang2, text string) {
+	key := r.key(lang2, text)
+	r.afterCommit(func() { r.cache.Remove(key) })
+}
+
+func (r *cachedTranslationCacheRepository) get(lang2 domain.Lang2, text string) (*providerCacheEntry, bool) {
+	v, ok := r.cache.Get(r.key(lang2, text))
+	countRepositoryCache(r.providerName, ok)
+	if !ok {
+		return nil, false
+	}
+	return v.(*providerCacheEntry), true
+}
+
+func (r *cachedTranslationCacheRepository) find(ctx context.Context, lang2 domain.Lang2, text string) (*providerCacheEntry, error) {
+	if entry, ok := r.get(lang2, text); ok {
+		return entry, nil
+	}
+
+	results, err := r.repo.FindByTexts(ctx, lang2, []string{text})
+	if err != nil {
+		return nil, err
+	}
+
+	translations, contained := results[text]
+	entry := &providerCacheEntry{contained: contained, results: translations}
+	r.cache.Set(r.key(lang2, text), entry)
+	return entry, nil
+}
+
+func (r *cachedTranslationCacheRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	defer r.invalidate(lang2, text)
+	return r.repo.Add(ctx, lang2, text, result)
+}
+
+func (r *cachedTranslationCacheRepository) AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error {
+	defer r.invalidate(lang2, text)
+	return r.repo.AddNegative(ctx, lang2, text)
+}
+
+func (r *cachedTranslationCacheRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.TranslationCandidate, error) {
+	entry, err := r.find(ctx, lang2, text)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.contained {
+		return nil, service.ErrTranslationNotFound
+	}
+	return append([]service.TranslationCandidate{}, entry.results...), nil
+}
+
+func (r *cachedTranslationCacheRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]service.TranslationCandidate, error) {
+	results := make(map[string][]service.TranslationCandidate)
+	missed := make([]string, 0)
+	for _, text := range texts {
+		entry, ok := r.get(lang2, text)
+		if !ok {
+			missed = append(missed, text)
+			continue
+		}
+		if entry.contained {
+			results[text] = append([]service.TranslationCandidate{}, entry.results...)
+		}
+	}
+	if len(missed) == 0 {
+		return results, nil
+	}
+
+	missedResults, err := r.repo.FindByTexts(ctx, lang2, missed)
+	if err != nil {
+		return nil, err
+	}
+	for _, text := range missed {
+		translations, contained := missedResults[text]
+		r.cache.Set(r.key(lang2, text), &providerCacheEntry{contained: contained, results: translations})
+		if contained {
+			results[text] = translations
+		}
+	}
+	return results, nil
+}
+
+func (r *cachedTranslationCacheRepository) Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error) {
+	entry, err := r.find(ctx, lang2, text)
+	if err != nil {
+		return false, err
+	}
+	return entry.contained, nil
+}
+
+func (r *cachedTranslationCacheRepository) FindByTarget(ctx context.Context, lang2 domain.Lang2, target string, prefix bool) ([]service.TranslationCacheEntry, error) {
+	return r.repo.FindByTarget(ctx, lang2, target, prefix)
+}
+
+func (r *cachedTranslationCacheRepository) FindStale(ctx context.Context, refreshedBefore time.Time, limit int) ([]service.TranslationCacheEntry, error) {
+	return r.repo.FindStale(ctx, refreshedBefore, limit)
+}
+
+func (r *cachedTranslationCacheRepository) Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	defer r.invalidate(lang2, text)
+	return r.repo.Refresh(ctx, lang2, text, result)
+}
+
+func (r *cachedTranslationCacheRepository) Touch(ctx context.Context, lang2 domain.Lang2, text string) error {
+	return r.repo.Touch(ctx, lang2, text)
+}
+
+type cachedAzureTranslationRepository struct {
+	service.AzureTranslationRepository
+	cached *cachedTranslationCacheRepository
+}
+
+func (r *cachedAzureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	return r.cached.Add(ctx, lang2, text, result)
+}
+
+func (r *cachedAzureTranslationRepository) AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error {
+	return r.cached.AddNegative(ctx, lang2, text)
+}
+
+func (r *cachedAzureTranslationRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.TranslationCandidate, error) {
+	return r.cached.Find(ctx, lang2, text)
+}
+
+func (r *cachedAzureTranslationRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]service.TranslationCandidate, error) {
+	return r.cached.FindByTexts(ctx, lang2, texts)
+}
+
+func (r *cachedAzureTranslationRepository) Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error) {
+	return r.cached.Contain(ctx, lang2, text)
+}
+
+func (r *cachedAzureTranslationRepository) Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	return r.cached.Refresh(ctx, lang2, text, result)
+}
+
+func (r *cachedAzureTranslationRepository) RemoveNegatives(ctx context.Context, lang2 domain.Lang2, text string) (int64, error) {
+	if text == "" {
+		defer r.cached.afterCommit(r.cached.cache.Purge)
+	} else {
+		defer r.cached.invalidate(lang2, text)
+	}
+	return r.AzureTranslationRepository.RemoveNegatives(ctx, lang2, text)
+}
+
+// cachedCustomTranslationRepository caches the results of FindByText and Contain separately.
+type cachedCustomTranslationRepository struct {
+	service.CustomTranslationRepository
+	cache       cache.LRUCache
+	afterCommit func(hook func())
+}
+
+func (r *cachedCustomTranslationRepository) findByTextKey(lang2 domain.Lang2, text string) string {
+	return "text\x00" + lang2.String() + "\x00" + text
+}
+
+func (r *cachedCustomTranslationRepository) containKey(lang2 domain.Lang2, text string) string {
+	return "contain\x00" + lang2.String() + "\x00" + text
+}
+
+func (r *cachedCustomTranslationRepository) invalidate(lang2 domain.Lang2, text string) {
+	keys := []string{r.findByTextKey(lang2, text), r.containKey(lang2, text)}
+	r.afterCommit(func() { r.cache.Remove(keys...) })
+}
+
+func (r *cachedCustomTranslationRepository) get(key string) (interface{}, bool) {
+	v, ok := r.cache.Get(key)
+	countRepositoryCache(service.TranslationProviderCustom, ok)
+	return v, ok
+}
+
+func (r *cachedCustomTranslationRepository) Add(ctx context.Context, param service.TranslationAddParameter) error {
+	defer r.invalidate(param.GetLang2(), param.GetText())
+	return r.CustomTranslationRepository.Add(ctx, param)
+}
+
+func (r *cachedCustomTranslationRepository) Update(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, param service.TranslationUpdateParameter) error {
+	defer r.invalidate(lang2, text)
+	return r.CustomTranslationRepository.Update(ctx, lang2, text, pos, param)
+}
+
+func (r *cachedCustomTranslationRepository) Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	defer r.invalidate(lang2, text)
+	return r.CustomTranslationRepository.Remove(ctx, lang2, text, pos, version)
+}
+
+func (r *cachedCustomTranslationRepository) Restore(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	defer r.invalidate(lang2, text)
+	return r.CustomTranslationRepository.Restore(ctx, lang2, text, pos, version)
+}
+
+func (r *cachedCustomTranslationRepository) FindByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error) {
+	key := r.findByTextKey(lang2, text)
+	if v, ok := r.get(key); ok {
+		return append([]domain.Translation{}, v.([]domain.Translation)...), nil
+	}
+
+	results, err := r.CustomTranslationRepository.FindByText(ctx, lang2, text)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.Set(key, results)
+	return append([]domain.Translation{}, results...), nil
+}
+
+func (r *cachedCustomTranslationRepository) Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error) {
+	key := r.containKey(lang2, text)
+	if v, ok := r.get(key); ok {
+		return v.(bool), nil
+	}
+
+	contained, err := r.CustomTranslationRepository.Contain(ctx, lang2, text)
+	if err != nil {
+		return false, err
+	}
+	r.cache.Set(key, contained)
+	return contained, nil
+}
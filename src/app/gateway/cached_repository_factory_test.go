@@ -0,0 +1,182 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	service_mock "github.com/kujilabo/cocotola-translator-api/src/app/service/mock"
+)
+
+func Test_cachedRepositoryFactory_NewTranslationCacheRepository(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	azureRepo := new(service_mock.AzureTranslationRepository)
+	azureRepo.On("FindByTexts", bg, domain.Lang2JA, []string{"book"}).Return(map[string][]service.TranslationCandidate{
+		"book": {{Pos: domain.PosNoun, Target: "本", Confidence: 1}},
+	}, nil)
+	azureRepo.On("FindByTexts", bg, domain.Lang2JA, []string{"bokk", "pen"}).Return(map[string][]service.TranslationCandidate{
+		"bokk": {},
+	}, nil)
+	azureRepo.On("Add", bg, domain.Lang2JA, "pen", []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "ペン"}}).Return(nil)
+	azureRepo.On("FindByTexts", bg, domain.Lang2JA, []string{"pen"}).Return(map[string][]service.TranslationCandidate{
+		"pen": {{Pos: domain.PosNoun, Target: "ペン"}},
+	}, nil)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", bg, service.TranslationProviderAzure, domain.Lang2EN).Return(azureRepo, nil)
+	cachedRF, err := gateway.NewCachedRepositoryFactory(bg, rf, gateway.NewRepositoryCache(100, time.Minute))
+	require.NoError(t, err)
+	repo, err := cachedRF.NewTranslationCacheRepository(bg, service.TranslationProviderAzure, domain.Lang2EN)
+	require.NoError(t, err)
+
+	// when
+	// - "book" is looked up twice
+	contained, err := repo.Contain(bg, domain.Lang2JA, "book")
+	require.NoError(t, err)
+	assert.True(t, contained)
+	results, err := repo.Find(bg, domain.Lang2JA, "book")
+	require.NoError(t, err)
+
+	// then
+	// - the second lookup hits the cache
+	assert.Equal(t, "本", results[0].Target)
+	azureRepo.AssertNumberOfCalls(t, "FindByTexts", 1)
+
+	// when
+	// - only the texts which are not cached are looked up from the repository
+	batchResults, err := repo.FindByTexts(bg, domain.Lang2JA, []string{"book", "bokk", "pen"})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, 2, len(batchResults))
+	assert.Equal(t, 0, len(batchResults["bokk"]))
+	azureRepo.AssertNumberOfCalls(t, "FindByTexts", 2)
+	contained, err = repo.Contain(bg, domain.Lang2JA, "pen")
+	require.NoError(t, err)
+	assert.False(t, contained)
+	azureRepo.AssertNumberOfCalls(t, "FindByTexts", 2)
+
+	// when
+	// - "pen" is added
+	err = repo.Add(bg, domain.Lang2JA, "pen", []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "ペン"}})
+	require.NoError(t, err)
+	contained, err = repo.Contain(bg, domain.Lang2JA, "pen")
+	require.NoError(t, err)
+
+	// then
+	// - the entry of "pen" is invalidated
+	assert.True(t, contained)
+	azureRepo.AssertNumberOfCalls(t, "FindByTexts", 3)
+}
+
+func Test_cachedRepositoryFactory_NewCustomTranslationRepository(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	book, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本", service.TranslationProviderCustom)
+	require.NoError(t, err)
+	customRepo := new(service_mock.CustomTranslationRepository)
+	customRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
+	customRepo.On("FindByText", bg, domain.Lang2JA, "book").Return([]domain.Translation{book}, nil)
+	customRepo.On("Remove", bg, domain.Lang2JA, "book", domain.PosNoun, 0).Return(nil)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewCustomTranslationRepository", bg).Return(customRepo)
+	cachedRF, err := gateway.NewCachedRepositoryFactory(bg, rf, gateway.NewRepositoryCache(100, time.Minute))
+	require.NoError(t, err)
+
+	// when
+	// - "book" is looked up twice through different repositories
+	for i := 0; i < 2; i++ {
+		repo := cachedRF.NewCustomTranslationRepository(bg)
+		contained, err := repo.Contain(bg, domain.Lang2JA, "book")
+		require.NoError(t, err)
+		assert.True(t, contained)
+		results, err := repo.FindByText(bg, domain.Lang2JA, "book")
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+	}
+
+	// then
+	customRepo.AssertNumberOfCalls(t, "Contain", 1)
+	customRepo.AssertNumberOfCalls(t, "FindByText", 1)
+
+	// when
+	// - "book" is removed
+	repo := cachedRF.NewCustomTranslationRepository(bg)
+	require.NoError(t, repo.Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 0))
+	_, err = repo.Contain(bg, domain.Lang2JA, "book")
+	require.NoError(t, err)
+	_, err = repo.FindByText(bg, domain.Lang2JA, "book")
+	require.NoError(t, err)
+
+	// then
+	// - the entries of "book" are invalidated
+	customRepo.AssertNumberOfCalls(t, "Contain", 2)
+	customRepo.AssertNumberOfCalls(t, "FindByText", 2)
+}
+
+func Test_cachedRepositoryFactory_invalidateAfterTransaction(t *testing.T) {
+	bg := context.Background()
+	errRollback := errors.New("rollback")
+
+	for driverName, db := range dbList() {
+		// given
+		customRepo := new(service_mock.CustomTranslationRepository)
+		customRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
+		customRepo.On("Remove", bg, domain.Lang2JA, "book", domain.PosNoun, 0).Return(nil)
+		rf := new(service_mock.RepositoryFactory)
+		rf.On("NewCustomTranslationRepository", bg).Return(customRepo)
+		repositoryCache := gateway.NewRepositoryCache(100, time.Minute)
+		cachedRF, err := gateway.NewCachedRepositoryFactory(bg, rf, repositoryCache)
+		require.NoError(t, err)
+		tm := gateway.NewTransactionManager(db, func(ctx context.Context, tx *gorm.DB) (service.RepositoryFactory, error) {
+			return gateway.NewCachedRepositoryFactory(ctx, rf, repositoryCache)
+		})
+		repo := cachedRF.NewCustomTranslationRepository(bg)
+		_, err = repo.Contain(bg, domain.Lang2JA, "book")
+		require.NoError(t, err)
+
+		// when
+		// - "book" is removed in a transaction
+		err = tm.Do(bg, func(rf service.RepositoryFactory) error {
+			if err := rf.NewCustomTranslationRepository(bg).Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 0); err != nil {
+				return err
+			}
+			_, err := repo.Contain(bg, domain.Lang2JA, "book")
+			return err
+		})
+		require.NoError(t, err, driverName)
+
+		// then
+		// - the entry of "book" is not invalidated until the transaction is committed
+		customRepo.AssertNumberOfCalls(t, "Contain", 1)
+		_, err = repo.Contain(bg, domain.Lang2JA, "book")
+		require.NoError(t, err)
+		customRepo.AssertNumberOfCalls(t, "Contain", 2)
+
+		// when
+		// - the transaction is rolled back after "book" is removed
+		err = tm.Do(bg, func(rf service.RepositoryFactory) error {
+			if err := rf.NewCustomTranslationRepository(bg).Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 0); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		assert.ErrorIs(t, err, errRollback, driverName)
+
+		// then
+		// - the entry of "book" is invalidated as well
+		_, err = repo.Contain(bg, domain.Lang2JA, "book")
+		require.NoError(t, err)
+		customRepo.AssertNumberOfCalls(t, "Contain", 3)
+	}
+}
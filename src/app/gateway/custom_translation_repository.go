@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"regexp"
 	"strings"
@@ -28,19 +29,210 @@ type customTranslationDBEntity struct {
 	Pos        int
 	Lang2      string
 	Translated string
+	// Senses is a JSON array of customSenseJSONEntity
+	Senses string
+	Note   string
+	// Labels is a JSON array of strings
+	Labels string
+	// Tags is a JSON array of strings. They are copied to custom_translation_tag to search translations by tag.
+	Tags     string
+	Level    string
+	Disabled bool
+}
+
+type customTranslationTagDBEntity struct {
+	Text  string
+	Pos   int
+	Lang2 string
+	Tag   string
+}
+
+func (e *customTranslationTagDBEntity) TableName() string {
+	return "custom_translation_tag"
+}
+
+type customSenseJSONEntity struct {
+	Gloss string `json:"gloss"`
+	Note  string `json:"note,omitempty"`
+	Order int    `json:"order"`
 }
 
 func (e *customTranslationDBEntity) TableName() string {
 	return "custom_translation"
 }
 
+type customTranslationHistoryDBEntity struct {
+	Text          string
+	Pos           int
+	Lang2         string
+	Version       int
+	Action        string
+	Actor         string
+	OldTranslated string
+	NewTranslated string
+	// NewSenses is a JSON array of customSenseJSONEntity
+	NewSenses string
+	CreatedAt time.Time
+}
+
+func (e *customTranslationHistoryDBEntity) TableName() string {
+	return "custom_translation_history"
+}
+
+func (e *customTranslationHistoryDBEntity) toModel() (service.CustomTranslationHistory, error) {
+	newSenses, err := unmarshalSenses(e.NewSenses, e.NewTranslated)
+	if err != nil {
+		return service.CustomTranslationHistory{}, err
+	}
+
+	return service.CustomTranslationHistory{
+		Version:       e.Version,
+		Action:        e.Action,
+		Actor:         e.Actor,
+		OldTranslated: e.OldTranslated,
+		NewTranslated: e.NewTranslated,
+		NewSenses:     newSenses,
+		CreatedAt:     e.CreatedAt,
+	}, nil
+}
+
+// newCustomTranslationValues returns the entity which has the values of the columns to be registered
+func newCustomTranslationValues(translated string, senses []domain.Sense, annotation domain.TranslationAnnotation) (*customTranslationDBEntity, error) {
+	sensesJSON, err := marshalSenses(senses)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := marshalStrings(annotation.Labels)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := marshalStrings(annotation.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customTranslationDBEntity{
+		Translated: translated,
+		Senses:     sensesJSON,
+		Note:       annotation.Note,
+		Labels:     labels,
+		Tags:       tags,
+		Level:      annotation.Level,
+	}, nil
+}
+
+func marshalStrings(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	bytes, err := json.Marshal(values)
+	if err != nil {
+		return "", liberrors.Errorf("failed to marshal strings. err: %w", err)
+	}
+	return string(bytes), nil
+}
+
+func unmarshalStrings(v string) ([]string, error) {
+	values := []string{}
+	if v == "" {
+		return values, nil
+	}
+	if err := json.Unmarshal([]byte(v), &values); err != nil {
+		return nil, liberrors.Errorf("failed to unmarshal strings. err: %w", err)
+	}
+	return values, nil
+}
+
+func (e *customTranslationDBEntity) toAnnotation() (domain.TranslationAnnotation, error) {
+	labels, err := unmarshalStrings(e.Labels)
+	if err != nil {
+		return domain.TranslationAnnotation{}, err
+	}
+	tags, err := unmarshalStrings(e.Tags)
+	if err != nil {
+		return domain.TranslationAnnotation{}, err
+	}
+
+	return domain.TranslationAnnotation{
+		Note:   e.Note,
+		Labels: labels,
+		Tags:   tags,
+		Level:  e.Level,
+	}, nil
+}
+
+func marshalSenses(senses []domain.Sense) (string, error) {
+	senseEntities := make([]customSenseJSONEntity, len(senses))
+	for i, s := range senses {
+		senseEntities[i] = customSenseJSONEntity{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: s.Order,
+		}
+	}
+	bytes, err := json.Marshal(senseEntities)
+	if err != nil {
+		return "", liberrors.Errorf("failed to marshal senses. err: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// unmarshalSenses returns the senses in the JSON array.
+// The translations registered before senses were introduced have the translated text as the only sense.
+func unmarshalSenses(v, translated string) ([]domain.Sense, error) {
+	senseEntities := []customSenseJSONEntity{}
+	if v != "" {
+		if err := json.Unmarshal([]byte(v), &senseEntities); err != nil {
+			return nil, liberrors.Errorf("failed to unmarshal senses. err: %w", err)
+		}
+	}
+	if len(senseEntities) == 0 {
+		if translated == "" {
+			return nil, nil
+		}
+		return []domain.Sense{{Gloss: translated, Order: 1}}, nil
+	}
+
+	senses := make([]domain.Sense, len(senseEntities))
+	for i, s := range senseEntities {
+		senses[i] = domain.Sense{
+			Gloss: s.Gloss,
+			Note:  s.Note,
+			Order: s.Order,
+		}
+	}
+	return senses, nil
+}
+
 func (e *customTranslationDBEntity) toModel() (domain.Translation, error) {
 	lang2, err := domain.NewLang2(e.Lang2)
 	if err != nil {
 		return nil, err
 	}
 
-	t, err := domain.NewTranslation(e.Version, e.CreatedAt, e.UpdatedAt, e.Text, domain.WordPos(e.Pos), lang2, e.Translated, "custom")
+	senses, err := unmarshalSenses(e.Senses, e.Translated)
+	if err != nil {
+		return nil, err
+	}
+
+	annotation, err := e.toAnnotation()
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Disabled {
+		return domain.NewDisabledTranslation(e.Version, e.CreatedAt, e.UpdatedAt, e.Text, domain.WordPos(e.Pos), lang2, e.Translated, service.TranslationProviderCustom, domain.TranslationDetail{
+			Senses:     senses,
+			Annotation: annotation,
+		})
+	}
+
+	// custom translations are registered by hand so that they are regarded as fully reliable
+	t, err := domain.NewTranslationWithDetail(e.Version, e.CreatedAt, e.UpdatedAt, e.Text, domain.WordPos(e.Pos), lang2, e.Translated, service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Senses:     senses,
+		Annotation: annotation,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -57,53 +249,301 @@ func (r *customTranslationRepository) Add(ctx context.Context, param service.Tra
 	_, span := tracer.Start(ctx, "customTranslationRepository.Add")
 	defer span.End()
 
-	entity := customTranslationDBEntity{
-		Version:    1,
-		Text:       param.GetText(),
-		Lang2:      param.GetLang2().String(),
-		Pos:        int(param.GetPos()),
-		Translated: param.GetTranslated(),
+	values, err := newCustomTranslationValues(param.GetTranslated(), param.GetSenses(), param.GetAnnotation())
+	if err != nil {
+		return err
 	}
 
-	if result := r.db.Create(&entity); result.Error != nil {
-		err := libG.ConvertDuplicatedError(result.Error, service.ErrTranslationAlreadyExists)
-		return liberrors.Errorf("failed to Add translation. err: %w", err)
-	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity, err := r.findEntity(tx, param.GetLang2(), param.GetText(), param.GetPos())
+		if err != nil && !errors.Is(err, service.ErrTranslationNotFound) {
+			return err
+		}
+		if err == nil {
+			if !entity.Disabled {
+				return liberrors.Errorf("failed to Add translation. err: %w", service.ErrTranslationAlreadyExists)
+			}
+			// the removed translation is added again
+			return r.enable(ctx, tx, entity, values, service.CustomTranslationActionAdd)
+		}
 
-	return nil
+		lastVersion, err := r.findLastVersion(tx, param.GetLang2(), param.GetText(), param.GetPos())
+		if err != nil {
+			return err
+		}
+
+		entity = values
+		entity.Version = lastVersion + 1
+		entity.Text = param.GetText()
+		entity.Lang2 = param.GetLang2().String()
+		entity.Pos = int(param.GetPos())
+
+		if result := tx.Create(entity); result.Error != nil {
+			err := libG.ConvertDuplicatedError(result.Error, service.ErrTranslationAlreadyExists)
+			return liberrors.Errorf("failed to Add translation. err: %w", err)
+		}
+
+		if err := r.replaceTags(tx, entity); err != nil {
+			return err
+		}
+
+		return r.addHistory(ctx, tx, entity, service.CustomTranslationActionAdd, "", entity.Translated, entity.Senses)
+	})
 }
 
 func (r *customTranslationRepository) Update(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, param service.TranslationUpdateParameter) error {
 	_, span := tracer.Start(ctx, "customTranslationRepository.Update")
 	defer span.End()
 
-	result := r.db.Model(&customTranslationDBEntity{}).
-		Where("lang2 = ? and text = ? and pos = ?",
-			lang2.String(), text, int(pos)).
-		Updates(map[string]interface{}{
-			"translated": param.GetTranslated(),
-		})
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity, err := r.findEntity(tx, lang2, text, pos)
+		if err != nil {
+			if errors.Is(err, service.ErrTranslationNotFound) && param.GetVersion() != 0 {
+				// the translation has been removed by someone else
+				return service.ErrVersionConflict
+			}
+			return err
+		}
+		if param.GetVersion() != 0 && param.GetVersion() != entity.Version {
+			return service.ErrVersionConflict
+		}
+
+		// the fields of the annotation which are not specified are kept
+		current, err := entity.toAnnotation()
+		if err != nil {
+			return err
+		}
+		values, err := newCustomTranslationValues(param.GetTranslated(), param.GetSenses(), param.GetAnnotationUpdate().Apply(current))
+		if err != nil {
+			return err
+		}
+
+		return r.enable(ctx, tx, entity, values, service.CustomTranslationActionUpdate)
+	})
+}
+
+func (r *customTranslationRepository) Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	_, span := tracer.Start(ctx, "customTranslationRepository.Remove")
+	defer span.End()
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity, err := r.findEntity(tx, lang2, text, pos)
+		if errors.Is(err, service.ErrTranslationNotFound) {
+			if version != 0 {
+				// the translation has been removed by someone else
+				return service.ErrVersionConflict
+			}
+			return r.addTombstone(ctx, tx, lang2, text, pos)
+		} else if err != nil {
+			return err
+		}
+		if version != 0 && version != entity.Version {
+			return service.ErrVersionConflict
+		}
+		if entity.Disabled {
+			return nil
+		}
+
+		if err := r.updateEntity(tx, entity, map[string]interface{}{
+			"disabled": true,
+		}); err != nil {
+			return err
+		}
+
+		return r.addHistory(ctx, tx, entity, service.CustomTranslationActionRemove, entity.Translated, "", "[]")
+	})
+}
+
+func (r *customTranslationRepository) Restore(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	_, span := tracer.Start(ctx, "customTranslationRepository.Restore")
+	defer span.End()
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		entity, err := r.findEntity(tx, lang2, text, pos)
+		if err != nil {
+			return err
+		}
+		if !entity.Disabled {
+			return service.ErrTranslationNotFound
+		}
+		if version != 0 && version != entity.Version {
+			return service.ErrVersionConflict
+		}
+
+		if entity.Translated != "" {
+			values := *entity
+			return r.enable(ctx, tx, entity, &values, service.CustomTranslationActionRestore)
+		}
+
+		// the translations of the providers are shown again
+		result := tx.
+			Where("lang2 = ? and text = ? and pos = ? and version = ?",
+				lang2.String(), text, int(pos), entity.Version).
+			Delete(&customTranslationDBEntity{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			// the translation has been updated or removed by someone else
+			return service.ErrVersionConflict
+		}
+
+		entity.Version++
+		return r.addHistory(ctx, tx, entity, service.CustomTranslationActionRestore, "", "", "[]")
+	})
+}
+
+// enable sets the values of the columns to the translation and enables it
+func (r *customTranslationRepository) enable(ctx context.Context, tx *gorm.DB, entity *customTranslationDBEntity, values *customTranslationDBEntity, action string) error {
+	oldTranslated := entity.Translated
+	if entity.Disabled {
+		oldTranslated = ""
+	}
+
+	if err := r.updateEntity(tx, entity, map[string]interface{}{
+		"translated": values.Translated,
+		"senses":     values.Senses,
+		"note":       values.Note,
+		"labels":     values.Labels,
+		"tags":       values.Tags,
+		"level":      values.Level,
+		"disabled":   false,
+	}); err != nil {
+		return err
+	}
+
+	entity.Translated = values.Translated
+	entity.Senses = values.Senses
+	entity.Note = values.Note
+	entity.Labels = values.Labels
+	entity.Tags = values.Tags
+	entity.Level = values.Level
+	entity.Disabled = false
+	if err := r.replaceTags(tx, entity); err != nil {
+		return err
+	}
+
+	return r.addHistory(ctx, tx, entity, action, oldTranslated, entity.Translated, entity.Senses)
+}
+
+// replaceTags copies the tags of the translation to custom_translation_tag
+func (r *customTranslationRepository) replaceTags(tx *gorm.DB, entity *customTranslationDBEntity) error {
+	if result := tx.
+		Where("lang2 = ? and text = ? and pos = ?", entity.Lang2, entity.Text, entity.Pos).
+		Delete(&customTranslationTagDBEntity{}); result.Error != nil {
+		return liberrors.Errorf("failed to remove tags of translation. err: %w", result.Error)
+	}
+
+	tags, err := unmarshalStrings(entity.Tags)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	tagEntities := make([]customTranslationTagDBEntity, len(tags))
+	for i, tag := range tags {
+		tagEntities[i] = customTranslationTagDBEntity{
+			Text:  entity.Text,
+			Pos:   entity.Pos,
+			Lang2: entity.Lang2,
+			Tag:   tag,
+		}
+	}
+	if result := tx.Create(&tagEntities); result.Error != nil {
+		return liberrors.Errorf("failed to add tags of translation. err: %w", result.Error)
+	}
+	return nil
+}
+
+// updateEntity updates the columns of the translation on the condition that its version has not been changed, and increments the version of the entity
+func (r *customTranslationRepository) updateEntity(tx *gorm.DB, entity *customTranslationDBEntity, values map[string]interface{}) error {
+	values["version"] = gorm.Expr("version + 1")
+	result := tx.Model(&customTranslationDBEntity{}).
+		Where("lang2 = ? and text = ? and pos = ? and version = ?",
+			entity.Lang2, entity.Text, entity.Pos, entity.Version).
+		Updates(values)
 	if result.Error != nil {
 		return libG.ConvertDuplicatedError(result.Error, service.ErrTranslationAlreadyExists)
 	}
 
 	if result.RowsAffected != 1 {
-		return errors.New("Error")
+		// the translation has been updated or removed by someone else
+		return service.ErrVersionConflict
 	}
 
+	entity.Version++
 	return nil
 }
 
-func (r *customTranslationRepository) Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error {
-	_, span := tracer.Start(ctx, "customTranslationRepository.Remove")
-	defer span.End()
+// addTombstone adds the disabled translation without translated text which suppresses the translations of the providers
+func (r *customTranslationRepository) addTombstone(ctx context.Context, tx *gorm.DB, lang2 domain.Lang2, text string, pos domain.WordPos) error {
+	lastVersion, err := r.findLastVersion(tx, lang2, text, pos)
+	if err != nil {
+		return err
+	}
+
+	entity := customTranslationDBEntity{
+		Version:  lastVersion + 1,
+		Text:     text,
+		Lang2:    lang2.String(),
+		Pos:      int(pos),
+		Senses:   "[]",
+		Labels:   "[]",
+		Tags:     "[]",
+		Disabled: true,
+	}
+	if result := tx.Create(&entity); result.Error != nil {
+		err := libG.ConvertDuplicatedError(result.Error, service.ErrVersionConflict)
+		return liberrors.Errorf("failed to add disabled translation. err: %w", err)
+	}
 
-	result := r.db.
+	return r.addHistory(ctx, tx, &entity, service.CustomTranslationActionRemove, "", "", "[]")
+}
+
+// findLastVersion returns the last version in the history. It returns 0 if the translation has no history.
+func (r *customTranslationRepository) findLastVersion(tx *gorm.DB, lang2 domain.Lang2, text string, pos domain.WordPos) (int, error) {
+	var lastVersion int
+	if result := tx.Model(&customTranslationHistoryDBEntity{}).
 		Where("lang2 = ? and text = ? and pos = ?",
 			lang2.String(), text, int(pos)).
-		Delete(&customTranslationDBEntity{})
-	if result.Error != nil {
-		return result.Error
+		Select("coalesce(max(version), 0)").
+		Scan(&lastVersion); result.Error != nil {
+		return 0, result.Error
+	}
+	return lastVersion, nil
+}
+
+func (r *customTranslationRepository) findEntity(tx *gorm.DB, lang2 domain.Lang2, text string, pos domain.WordPos) (*customTranslationDBEntity, error) {
+	entity := customTranslationDBEntity{}
+	if result := tx.Where(&customTranslationDBEntity{
+		Text:  text,
+		Lang2: lang2.String(),
+		Pos:   int(pos),
+	}).First(&entity); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, service.ErrTranslationNotFound
+		}
+		return nil, result.Error
+	}
+	return &entity, nil
+}
+
+func (r *customTranslationRepository) addHistory(ctx context.Context, tx *gorm.DB, entity *customTranslationDBEntity, action, oldTranslated, newTranslated, newSenses string) error {
+	history := customTranslationHistoryDBEntity{
+		Text:          entity.Text,
+		Pos:           entity.Pos,
+		Lang2:         entity.Lang2,
+		Version:       entity.Version,
+		Action:        action,
+		Actor:         service.ActorFromContext(ctx),
+		OldTranslated: oldTranslated,
+		NewTranslated: newTranslated,
+		NewSenses:     newSenses,
+	}
+	if result := tx.Create(&history); result.Error != nil {
+		return liberrors.Errorf("failed to add history of translation. err: %w", result.Error)
 	}
 	return nil
 }
@@ -189,6 +629,100 @@ func (r *customTranslationRepository) FindByFirstLetter(ctx context.Context, lan
 	return results, nil
 }
 
+func (r *customTranslationRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) ([]domain.Translation, error) {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindByTexts")
+	defer span.End()
+
+	if len(texts) == 0 {
+		return []domain.Translation{}, nil
+	}
+
+	entities := []customTranslationDBEntity{}
+	if result := r.db.Where("lang2 = ? and text in ?", lang2.String(), texts).
+		Order("text").Order("pos").Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]domain.Translation, len(entities))
+	for i, e := range entities {
+		t, err := e.toModel()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = t
+	}
+	return results, nil
+}
+
+func (r *customTranslationRepository) FindByTranslated(ctx context.Context, lang2 domain.Lang2, translated string, prefix bool) ([]domain.Translation, error) {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindByTranslated")
+	defer span.End()
+
+	db := r.db.Where("lang2 = ?", lang2.String())
+	if prefix {
+		db = db.Where("translated like ? escape '"+libG.LikeEscape+"'", libG.PrefixLikePattern(translated))
+	} else {
+		db = db.Where("translated = ?", translated)
+	}
+
+	entities := []customTranslationDBEntity{}
+	if result := db.Order("text").Order("pos").Limit(reverseLookupMaxResults).Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]domain.Translation, len(entities))
+	for i, e := range entities {
+		t, err := e.toModel()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = t
+	}
+	return results, nil
+}
+
+func (r *customTranslationRepository) FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func(translations []domain.Translation) error) error {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindByLang2InBatches")
+	defer span.End()
+
+	if batchSize <= 0 {
+		return libD.ErrInvalidArgument
+	}
+
+	lastText := ""
+	lastPos := 0
+	for {
+		entities := []customTranslationDBEntity{}
+		if result := r.db.Where("lang2 = ?", lang2.String()).
+			Where("text > ? or (text = ? and pos > ?)", lastText, lastText, lastPos).
+			Order("text").Order("pos").Limit(batchSize).Find(&entities); result.Error != nil {
+			return result.Error
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+
+		results := make([]domain.Translation, len(entities))
+		for i, e := range entities {
+			t, err := e.toModel()
+			if err != nil {
+				return err
+			}
+			results[i] = t
+		}
+
+		if err := fn(results); err != nil {
+			return err
+		}
+
+		if len(entities) < batchSize {
+			return nil
+		}
+		lastText = entities[len(entities)-1].Text
+		lastPos = entities[len(entities)-1].Pos
+	}
+}
+
 // func (r *azureTranslationRepository) FindTranslations(ctx context.Context, param *domain.AzureTranslationSearchCondition) (*domain.AzureTranslation, error) {
 // 	limit := param.PageSize
 // 	offset := (param.PageNo - 1) * param.PageSize
@@ -221,9 +755,9 @@ func (r *customTranslationRepository) Contain(ctx context.Context, lang2 domain.
 	_, span := tracer.Start(ctx, "customTranslationRepository.Contain")
 	defer span.End()
 
-	entity := azureTranslationDBEntity{}
+	entity := customTranslationDBEntity{}
 
-	if result := r.db.Where(&azureTranslationDBEntity{
+	if result := r.db.Where(&customTranslationDBEntity{
 		Text:  text,
 		Lang2: lang2.String(),
 	}).First(&entity); result.Error != nil {
@@ -235,3 +769,92 @@ func (r *customTranslationRepository) Contain(ctx context.Context, lang2 domain.
 
 	return true, nil
 }
+
+func (r *customTranslationRepository) FindHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]service.CustomTranslationHistory, error) {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindHistory")
+	defer span.End()
+
+	entities := []customTranslationHistoryDBEntity{}
+	if result := r.db.Where("lang2 = ? and text = ? and pos = ?",
+		lang2.String(), text, int(pos)).
+		Order("version desc").
+		Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]service.CustomTranslationHistory, len(entities))
+	for i, e := range entities {
+		h, err := e.toModel()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = h
+	}
+	return results, nil
+}
+
+func (r *customTranslationRepository) FindDisabled(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error) {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindDisabled")
+	defer span.End()
+
+	entities := []customTranslationDBEntity{}
+	if result := r.db.Where("lang2 = ? and disabled = ?", lang2.String(), true).
+		Order("text").Order("pos").Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]domain.Translation, len(entities))
+	for i, e := range entities {
+		t, err := e.toModel()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = t
+	}
+	return results, nil
+}
+
+func (r *customTranslationRepository) FindByCondition(ctx context.Context, lang2 domain.Lang2, condition *service.CustomTranslationSearchCondition) (*service.CustomTranslationSearchResult, error) {
+	_, span := tracer.Start(ctx, "customTranslationRepository.FindByCondition")
+	defer span.End()
+
+	if condition.PageNo <= 0 || condition.PageSize <= 0 {
+		return nil, libD.ErrInvalidArgument
+	}
+
+	limit := condition.PageSize
+	offset := (condition.PageNo - 1) * condition.PageSize
+
+	db := r.db.Where("lang2 = ? and disabled = ?", lang2.String(), false)
+	if condition.Level != "" {
+		db = db.Where("level = ?", condition.Level)
+	}
+	if condition.Tag != "" {
+		db = db.Where("exists (select 1 from custom_translation_tag t where t.lang2 = custom_translation.lang2 and t.text = custom_translation.text and t.pos = custom_translation.pos and t.tag = ?)", condition.Tag)
+	}
+	db = db.Session(&gorm.Session{})
+
+	entities := []customTranslationDBEntity{}
+	if result := db.Order("text").Order("pos").Limit(limit).Offset(offset).Find(&entities); result.Error != nil {
+		return nil, result.Error
+	}
+
+	var count int64
+	if result := db.Model(&customTranslationDBEntity{}).Count(&count); result.Error != nil {
+		return nil, result.Error
+	}
+
+	results := make([]domain.Translation, len(entities))
+	for i, e := range entities {
+		t, err := e.toModel()
+		if err != nil {
+			return nil, err
+		}
+		results[i] = t
+	}
+
+	return &service.CustomTranslationSearchResult{
+		TotalCount: count,
+		Results:    results,
+	}, nil
+}
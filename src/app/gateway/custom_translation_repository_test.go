@@ -13,6 +13,8 @@ import (
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	libD "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
 )
 
 func Test_customTranslationRepository_FindByText(t *testing.T) {
@@ -79,3 +81,364 @@ func Test_customTranslationRepository_FindByFirstLetter(t *testing.T) {
 		}
 	}
 }
+
+func Test_customTranslationRepository_FindByTranslated(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation")
+		assert.NoError(t, result.Error)
+
+		// given
+		for _, v := range []struct {
+			text       string
+			pos        domain.WordPos
+			translated string
+		}{
+			{text: "book", pos: domain.PosVerb, translated: "予約する"},
+			{text: "reserve", pos: domain.PosVerb, translated: "予約"},
+			{text: "book", pos: domain.PosNoun, translated: "本"},
+		} {
+			result = db.Exec("insert into custom_translation (version,text,pos,lang2,translated) values(1,?,?,?,?)", v.text, int(v.pos), domain.Lang2JA.String(), v.translated)
+			assert.NoError(t, result.Error, driverName)
+		}
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// when
+		got, err := r.FindByTranslated(bg, domain.Lang2JA, "予約", false)
+		// then
+		assert.NoError(t, err, driverName)
+		if assert.Equal(t, 1, len(got), driverName) {
+			assert.Equal(t, "reserve", got[0].GetText(), driverName)
+		}
+
+		// when
+		got, err = r.FindByTranslated(bg, domain.Lang2JA, "予約", true)
+		// then
+		assert.NoError(t, err, driverName)
+		if assert.Equal(t, 2, len(got), driverName) {
+			assert.Equal(t, "book", got[0].GetText(), driverName)
+			assert.Equal(t, "reserve", got[1].GetText(), driverName)
+		}
+	}
+}
+
+func Test_customTranslationRepository_Update_Version(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation")
+		assert.NoError(t, result.Error)
+		result = db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation_history")
+		assert.NoError(t, result.Error)
+
+		// given
+		result = db.Exec("insert into custom_translation (version,text,pos,lang2,translated) values(1,'book',?,?,'本')", int(domain.PosNoun), domain.Lang2JA.String())
+		assert.NoError(t, result.Error, driverName)
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// when
+		// - the translation is updated with the latest version
+		param, err := service.NewTransaltionUpdateParameter("書籍", 1)
+		assert.NoError(t, err)
+		err = r.Update(bg, domain.Lang2JA, "book", domain.PosNoun, param)
+		// then
+		// - the version is incremented
+		assert.NoError(t, err, driverName)
+		got, err := r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, 2, got.GetVersion(), driverName)
+		assert.Equal(t, "書籍", got.GetTranslated(), driverName)
+
+		// when
+		// - the translation is updated with the stale version
+		param, err = service.NewTransaltionUpdateParameter("帳簿", 1)
+		assert.NoError(t, err)
+		err = r.Update(bg, domain.Lang2JA, "book", domain.PosNoun, param)
+		// then
+		assert.ErrorIs(t, err, service.ErrVersionConflict, driverName)
+
+		// when
+		// - the translation is removed with the stale version
+		err = r.Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 1)
+		// then
+		assert.ErrorIs(t, err, service.ErrVersionConflict, driverName)
+
+		// when
+		// - the translation is removed with the latest version
+		err = r.Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 2)
+		// then
+		assert.NoError(t, err, driverName)
+		got, err = r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.True(t, got.IsDisabled(), driverName)
+		assert.Equal(t, 3, got.GetVersion(), driverName)
+	}
+}
+
+func Test_customTranslationRepository_FindHistory(t *testing.T) {
+	bg := service.WithActor(context.Background(), "alice")
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation")
+		assert.NoError(t, result.Error)
+		result = db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation_history")
+		assert.NoError(t, result.Error)
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// given
+		// - "book" is added, updated, removed and added again
+		addParam, err := service.NewTransalationAddParameter("book", domain.PosNoun, domain.Lang2JA, "本")
+		assert.NoError(t, err)
+		assert.NoError(t, r.Add(bg, addParam), driverName)
+		updateParam, err := service.NewTransaltionUpdateParameter("書籍", 1)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Update(bg, domain.Lang2JA, "book", domain.PosNoun, updateParam), driverName)
+		assert.NoError(t, r.Remove(bg, domain.Lang2JA, "book", domain.PosNoun, 2), driverName)
+		assert.NoError(t, r.Add(bg, addParam), driverName)
+
+		// when
+		got, err := r.FindHistory(bg, domain.Lang2JA, "book", domain.PosNoun)
+
+		// then
+		assert.NoError(t, err, driverName)
+		type history struct {
+			version       int
+			action        string
+			actor         string
+			oldTranslated string
+			newTranslated string
+		}
+		actual := make([]history, len(got))
+		for i, h := range got {
+			actual[i] = history{h.Version, h.Action, h.Actor, h.OldTranslated, h.NewTranslated}
+		}
+		assert.Equal(t, []history{
+			{4, service.CustomTranslationActionAdd, "alice", "", "本"},
+			{3, service.CustomTranslationActionRemove, "alice", "書籍", ""},
+			{2, service.CustomTranslationActionUpdate, "alice", "本", "書籍"},
+			{1, service.CustomTranslationActionAdd, "alice", "", "本"},
+		}, actual, driverName)
+
+		// - the version of the translation added again follows the history
+		translation, err := r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, 4, translation.GetVersion(), driverName)
+	}
+}
+
+func Test_customTranslationRepository_Remove_Restore(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation")
+		assert.NoError(t, result.Error)
+		result = db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation_history")
+		assert.NoError(t, result.Error)
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// given
+		// - "can"(noun) is registered
+		addParam, err := service.NewTransalationAddParameter("can", domain.PosNoun, domain.Lang2JA, "缶")
+		assert.NoError(t, err)
+		assert.NoError(t, r.Add(bg, addParam), driverName)
+
+		// when
+		// - "can"(noun) is removed and "can"(verb), which is not registered, is removed
+		assert.NoError(t, r.Remove(bg, domain.Lang2JA, "can", domain.PosNoun, 1), driverName)
+		assert.NoError(t, r.Remove(bg, domain.Lang2JA, "can", domain.PosVerb, 0), driverName)
+
+		// then
+		// - they are kept as disabled translations
+		contained, err := r.Contain(bg, domain.Lang2JA, "can")
+		assert.NoError(t, err, driverName)
+		assert.True(t, contained, driverName)
+		got, err := r.FindDisabled(bg, domain.Lang2JA)
+		assert.NoError(t, err, driverName)
+		if assert.Equal(t, 2, len(got), driverName) {
+			assert.Equal(t, domain.PosNoun, got[0].GetPos(), driverName)
+			assert.Equal(t, "缶", got[0].GetTranslated(), driverName)
+			assert.Equal(t, 2, got[0].GetVersion(), driverName)
+			assert.True(t, got[0].IsDisabled(), driverName)
+			assert.Equal(t, domain.PosVerb, got[1].GetPos(), driverName)
+			assert.Equal(t, "", got[1].GetTranslated(), driverName)
+			assert.True(t, got[1].IsDisabled(), driverName)
+		}
+
+		// when
+		// - the translations are restored
+		assert.ErrorIs(t, r.Restore(bg, domain.Lang2JA, "can", domain.PosNoun, 1), service.ErrVersionConflict, driverName)
+		assert.NoError(t, r.Restore(bg, domain.Lang2JA, "can", domain.PosNoun, 2), driverName)
+		assert.NoError(t, r.Restore(bg, domain.Lang2JA, "can", domain.PosVerb, 0), driverName)
+
+		// then
+		// - the noun is enabled and the verb without translated text is deleted
+		noun, err := r.FindByTextAndPos(bg, domain.Lang2JA, "can", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.False(t, noun.IsDisabled(), driverName)
+		assert.Equal(t, 3, noun.GetVersion(), driverName)
+		_, err = r.FindByTextAndPos(bg, domain.Lang2JA, "can", domain.PosVerb)
+		assert.ErrorIs(t, err, service.ErrTranslationNotFound, driverName)
+		assert.ErrorIs(t, r.Restore(bg, domain.Lang2JA, "can", domain.PosNoun, 0), service.ErrTranslationNotFound, driverName)
+
+		// when
+		// - the removed translation is added again
+		assert.NoError(t, r.Remove(bg, domain.Lang2JA, "can", domain.PosNoun, 0), driverName)
+		addParam, err = service.NewTransalationAddParameter("can", domain.PosNoun, domain.Lang2JA, "缶詰")
+		assert.NoError(t, err)
+		assert.NoError(t, r.Add(bg, addParam), driverName)
+
+		// then
+		noun, err = r.FindByTextAndPos(bg, domain.Lang2JA, "can", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.False(t, noun.IsDisabled(), driverName)
+		assert.Equal(t, "缶詰", noun.GetTranslated(), driverName)
+		assert.Equal(t, 5, noun.GetVersion(), driverName)
+	}
+}
+
+func Test_customTranslationRepository_Senses(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation")
+		assert.NoError(t, result.Error)
+		result = db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from custom_translation_history")
+		assert.NoError(t, result.Error)
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// given
+		// - "book" is added with senses
+		addParam, err := service.NewTransalationAddParameterWithSenses("book", domain.PosNoun, domain.Lang2JA, []domain.Sense{
+			{Gloss: "書物", Note: "literary", Order: 2},
+			{Gloss: "本", Order: 1},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, r.Add(bg, addParam), driverName)
+		// - "pen" is registered without senses
+		result = db.Exec("insert into custom_translation (text,pos,lang2,translated) values('pen',6,'ja','ペン')")
+		assert.NoError(t, result.Error)
+
+		// when
+		book, err := r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		// then
+		// - the senses are in display order and translated is the glosses of them
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, "本, 書物", book.GetTranslated(), driverName)
+		assert.Equal(t, []domain.Sense{
+			{Gloss: "本", Order: 1},
+			{Gloss: "書物", Note: "literary", Order: 2},
+		}, book.GetSenses(), driverName)
+
+		// when
+		pen, err := r.FindByTextAndPos(bg, domain.Lang2JA, "pen", domain.PosNoun)
+		// then
+		// - the translated text is the only sense
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, []domain.Sense{{Gloss: "ペン", Order: 1}}, pen.GetSenses(), driverName)
+
+		// when
+		// - "book" is updated with a sense
+		updateParam, err := service.NewTransaltionUpdateParameterWithSenses([]domain.Sense{{Gloss: "書籍", Note: "formal"}}, 1)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Update(bg, domain.Lang2JA, "book", domain.PosNoun, updateParam), driverName)
+
+		// then
+		book, err = r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, "書籍", book.GetTranslated(), driverName)
+		assert.Equal(t, []domain.Sense{{Gloss: "書籍", Note: "formal", Order: 1}}, book.GetSenses(), driverName)
+		// - the history has the senses of each version
+		histories, err := r.FindHistory(bg, domain.Lang2JA, "book", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		if assert.Equal(t, 2, len(histories), driverName) {
+			assert.Equal(t, book.GetSenses(), histories[0].NewSenses, driverName)
+			assert.Equal(t, addParam.GetSenses(), histories[1].NewSenses, driverName)
+		}
+	}
+}
+
+func Test_customTranslationRepository_FindByCondition(t *testing.T) {
+	bg := context.Background()
+	for driverName, db := range dbList() {
+		for _, table := range []string{"custom_translation", "custom_translation_history", "custom_translation_tag"} {
+			result := db.Session(&gorm.Session{AllowGlobalUpdate: true}).Exec("delete from " + table)
+			assert.NoError(t, result.Error)
+		}
+		r := gateway.NewCustomTranslationRepository(db)
+
+		// given
+		// - "book" and "invoice" are tagged with business, and "invoice" is removed
+		// - "fever" is tagged with medical
+		add := func(text, translated string, annotation domain.TranslationAnnotation) {
+			param, err := service.NewTransalationAddParameterWithAnnotation(text, domain.PosNoun, domain.Lang2JA, []domain.Sense{{Gloss: translated}}, annotation)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Add(bg, param), driverName)
+		}
+		add("book", "本", domain.TranslationAnnotation{Note: "checked", Labels: []string{"Formal"}, Tags: []string{"business", "education"}, Level: "a1"})
+		add("fever", "熱", domain.TranslationAnnotation{Tags: []string{"medical"}, Level: "B1"})
+		add("invoice", "請求書", domain.TranslationAnnotation{Tags: []string{"business"}, Level: "B1"})
+		assert.NoError(t, r.Remove(bg, domain.Lang2JA, "invoice", domain.PosNoun, 0), driverName)
+
+		// when
+		book, err := r.FindByTextAndPos(bg, domain.Lang2JA, "book", domain.PosNoun)
+		// then
+		// - the annotation is normalized
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, domain.TranslationAnnotation{Note: "checked", Labels: []string{"formal"}, Tags: []string{"business", "education"}, Level: "A1"}, book.GetAnnotation(), driverName)
+
+		tests := []struct {
+			name      string
+			condition service.CustomTranslationSearchCondition
+			wantCount int64
+			wantTexts []string
+		}{
+			{name: "all", condition: service.CustomTranslationSearchCondition{PageNo: 1, PageSize: 10}, wantCount: 2, wantTexts: []string{"book", "fever"}},
+			{name: "tag", condition: service.CustomTranslationSearchCondition{Tag: "business", PageNo: 1, PageSize: 10}, wantCount: 1, wantTexts: []string{"book"}},
+			{name: "level", condition: service.CustomTranslationSearchCondition{Level: "B1", PageNo: 1, PageSize: 10}, wantCount: 1, wantTexts: []string{"fever"}},
+			{name: "tag and level", condition: service.CustomTranslationSearchCondition{Tag: "medical", Level: "A1", PageNo: 1, PageSize: 10}, wantCount: 0, wantTexts: []string{}},
+			{name: "second page", condition: service.CustomTranslationSearchCondition{PageNo: 2, PageSize: 1}, wantCount: 2, wantTexts: []string{"fever"}},
+		}
+		for _, tt := range tests {
+			// when
+			got, err := r.FindByCondition(bg, domain.Lang2JA, &tt.condition)
+
+			// then
+			assert.NoError(t, err, driverName, tt.name)
+			assert.Equal(t, tt.wantCount, got.TotalCount, driverName, tt.name)
+			texts := make([]string, len(got.Results))
+			for i, result := range got.Results {
+				texts[i] = result.GetText()
+			}
+			assert.Equal(t, tt.wantTexts, texts, driverName, tt.name)
+		}
+
+		// when
+		// - the tags of "book" are replaced
+		updateParam, err := service.NewTransaltionUpdateParameterWithAnnotation([]domain.Sense{{Gloss: "本"}}, domain.TranslationAnnotation{Tags: []string{"education"}}, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Update(bg, domain.Lang2JA, "book", domain.PosNoun, updateParam), driverName)
+
+		// then
+		got, err := r.FindByCondition(bg, domain.Lang2JA, &service.CustomTranslationSearchCondition{Tag: "business", PageNo: 1, PageSize: 10})
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, int64(0), got.TotalCount, driverName)
+
+		// when
+		// - only the note of "fever" is specified
+		note := "checked"
+		updateParam, err = service.NewTransaltionUpdateParameterWithAnnotationUpdate([]domain.Sense{{Gloss: "発熱"}}, service.TranslationAnnotationUpdate{Note: &note}, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, r.Update(bg, domain.Lang2JA, "fever", domain.PosNoun, updateParam), driverName)
+
+		// then
+		// - the tags and the level are kept
+		fever, err := r.FindByTextAndPos(bg, domain.Lang2JA, "fever", domain.PosNoun)
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, "発熱", fever.GetTranslated(), driverName)
+		assert.Equal(t, domain.TranslationAnnotation{Note: "checked", Labels: []string{}, Tags: []string{"medical"}, Level: "B1"}, fever.GetAnnotation(), driverName)
+		got, err = r.FindByCondition(bg, domain.Lang2JA, &service.CustomTranslationSearchCondition{Tag: "medical", PageNo: 1, PageSize: 10})
+		assert.NoError(t, err, driverName)
+		assert.Equal(t, int64(1), got.TotalCount, driverName)
+
+		// when
+		_, err = r.FindByCondition(bg, domain.Lang2JA, &service.CustomTranslationSearchCondition{PageNo: 0, PageSize: 10})
+		// then
+		assert.ErrorIs(t, err, libD.ErrInvalidArgument, driverName)
+	}
+}
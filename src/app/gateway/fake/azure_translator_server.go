@@ -0,0 +1,250 @@
+package fake
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/v3.0/translatortext"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+const azureTranslatorAPIVersion = "3.0"
+
+//go:embed azure_dictionary.json
+var defaultAzureDictionary []byte
+
+// AzureDictionary holds canned translations keyed by from, to and text.
+type AzureDictionary map[string]map[string]map[string][]translatortext.DictionaryLookupResultItemTranslationsItem
+
+// LoadAzureDictionary reads an AzureDictionary from JSON.
+func LoadAzureDictionary(r io.Reader) (AzureDictionary, error) {
+	dictionary := AzureDictionary{}
+	if err := json.NewDecoder(r).Decode(&dictionary); err != nil {
+		return nil, err
+	}
+	return dictionary, nil
+}
+
+// DefaultAzureDictionary returns the canned translations shipped with this package.
+func DefaultAzureDictionary() (AzureDictionary, error) {
+	return LoadAzureDictionary(bytes.NewReader(defaultAzureDictionary))
+}
+
+type azureTranslatorServer struct {
+	subscriptionKey string
+	region          string
+	dictionary      AzureDictionary
+}
+
+// NewAzureTranslatorHandler returns a stand-in for the Azure Translator API which serves dictionary/lookup from dictionary.
+// dictionary/examples returns one made-up example for each pair of a text and its translation in dictionary.
+// translate returns the text prefixed with the target language. Texts are detected as ja if they contain non-ASCII characters, en otherwise.
+// The subscription key and the region are checked only when they are not empty.
+func NewAzureTranslatorHandler(subscriptionKey, region string, dictionary AzureDictionary) http.Handler {
+	s := &azureTranslatorServer{
+		subscriptionKey: subscriptionKey,
+		region:          region,
+		dictionary:      dictionary,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/Dictionary/Lookup", s.dictionaryLookup)
+	mux.HandleFunc("/dictionary/lookup", s.dictionaryLookup)
+	mux.HandleFunc("/Dictionary/Examples", s.dictionaryExamples)
+	mux.HandleFunc("/dictionary/examples", s.dictionaryExamples)
+	mux.HandleFunc("/translate", s.translate)
+	return mux
+}
+
+// checkRequest writes the error and returns false if the request is invalid
+func (s *azureTranslatorServer) checkRequest(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	if !s.checkAuth(w, r) {
+		return "", "", false
+	}
+
+	query := r.URL.Query()
+	fromLang := query.Get("from")
+	toLang := query.Get("to")
+	if fromLang == "" || toLang == "" {
+		s.writeError(w, http.StatusBadRequest, "400036", "The source or target language is missing or invalid.")
+		return "", "", false
+	}
+	return fromLang, toLang, true
+}
+
+// checkAuth writes the error and returns false if the method, the credentials or the API version of the request is invalid
+func (s *azureTranslatorServer) checkAuth(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		s.writeError(w, http.StatusMethodNotAllowed, "405000", "The request method is not supported for the requested resource.")
+		return false
+	}
+	if s.subscriptionKey != "" && r.Header.Get("Ocp-Apim-Subscription-Key") != s.subscriptionKey {
+		s.writeError(w, http.StatusUnauthorized, "401000", "The request is not authorized because credentials are missing or invalid.")
+		return false
+	}
+	if s.region != "" && !strings.EqualFold(r.Header.Get("Ocp-Apim-Subscription-Region"), s.region) {
+		s.writeError(w, http.StatusUnauthorized, "401015", "The request is not authorized because the region is invalid.")
+		return false
+	}
+	if r.URL.Query().Get("api-version") != azureTranslatorAPIVersion {
+		s.writeError(w, http.StatusBadRequest, "400021", "The API version parameter is missing or invalid.")
+		return false
+	}
+	return true
+}
+
+func (s *azureTranslatorServer) dictionaryLookup(w http.ResponseWriter, r *http.Request) {
+	fromLang, toLang, ok := s.checkRequest(w, r)
+	if !ok {
+		return
+	}
+
+	inputs := make([]translatortext.DictionaryLookupTextInput, 0)
+	if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+		s.writeError(w, http.StatusBadRequest, "400074", "The body of the request is not valid JSON.")
+		return
+	}
+
+	results := make([]translatortext.DictionaryLookupResultItem, len(inputs))
+	for i, input := range inputs {
+		text := ""
+		if input.Text != nil {
+			text = *input.Text
+		}
+		normalized := strings.ToLower(strings.TrimSpace(text))
+
+		translations := s.dictionary[fromLang][toLang][normalized]
+		if translations == nil {
+			translations = make([]translatortext.DictionaryLookupResultItemTranslationsItem, 0)
+		}
+		results[i] = translatortext.DictionaryLookupResultItem{
+			NormalizedSource: to.StringPtr(normalized),
+			DisplaySource:    to.StringPtr(text),
+			Translations:     &translations,
+		}
+	}
+
+	s.writeJSON(w, http.StatusOK, results)
+}
+
+func (s *azureTranslatorServer) dictionaryExamples(w http.ResponseWriter, r *http.Request) {
+	fromLang, toLang, ok := s.checkRequest(w, r)
+	if !ok {
+		return
+	}
+
+	inputs := make([]translatortext.DictionaryExampleTextInput, 0)
+	if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+		s.writeError(w, http.StatusBadRequest, "400074", "The body of the request is not valid JSON.")
+		return
+	}
+
+	results := make([]translatortext.DictionaryExampleResultItem, len(inputs))
+	for i, input := range inputs {
+		text := ""
+		if input.Text != nil {
+			text = *input.Text
+		}
+		translation := ""
+		if input.Translation != nil {
+			translation = *input.Translation
+		}
+		normalizedText := strings.ToLower(strings.TrimSpace(text))
+		normalizedTranslation := strings.ToLower(strings.TrimSpace(translation))
+
+		examples := make([]translatortext.DictionaryExampleResultItemExamplesItem, 0)
+		for _, t := range s.dictionary[fromLang][toLang][normalizedText] {
+			if t.NormalizedTarget == nil || *t.NormalizedTarget != normalizedTranslation {
+				continue
+			}
+			examples = append(examples, translatortext.DictionaryExampleResultItemExamplesItem{
+				SourcePrefix: to.StringPtr("This is an example of "),
+				SourceTerm:   to.StringPtr(normalizedText),
+				SourceSuffix: to.StringPtr("."),
+				TargetPrefix: to.StringPtr(""),
+				TargetTerm:   to.StringPtr(normalizedTranslation),
+				TargetSuffix: to.StringPtr(" (example)"),
+			})
+			break
+		}
+		results[i] = translatortext.DictionaryExampleResultItem{
+			NormalizedSource: to.StringPtr(normalizedText),
+			NormalizedTarget: to.StringPtr(normalizedTranslation),
+			Examples:         &examples,
+		}
+	}
+
+	s.writeJSON(w, http.StatusOK, results)
+}
+
+func (s *azureTranslatorServer) translate(w http.ResponseWriter, r *http.Request) {
+	if !s.checkAuth(w, r) {
+		return
+	}
+
+	query := r.URL.Query()
+	fromLang := query.Get("from")
+	toLang := query.Get("to")
+	if toLang == "" {
+		s.writeError(w, http.StatusBadRequest, "400036", "The target language is not valid.")
+		return
+	}
+
+	inputs := make([]translatortext.TranslateTextInput, 0)
+	if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+		s.writeError(w, http.StatusBadRequest, "400074", "The body of the request is not valid JSON.")
+		return
+	}
+
+	results := make([]map[string]interface{}, len(inputs))
+	for i, input := range inputs {
+		text := ""
+		if input.Text != nil {
+			text = *input.Text
+		}
+
+		result := map[string]interface{}{
+			"translations": []map[string]interface{}{{
+				"text": "[" + toLang + "] " + text,
+				"to":   toLang,
+			}},
+		}
+		if fromLang == "" {
+			detected := map[string]interface{}{"language": "en", "score": 0.9}
+			for _, c := range text {
+				if c > unicode.MaxASCII {
+					detected = map[string]interface{}{"language": "ja", "score": 1.0}
+					break
+				}
+			}
+			// a language which has no ISO 639-1 code
+			if strings.HasPrefix(text, "Aloha") {
+				detected = map[string]interface{}{"language": "haw", "score": 0.8}
+			}
+			result["detectedLanguage"] = detected
+		}
+		results[i] = result
+	}
+
+	s.writeJSON(w, http.StatusOK, results)
+}
+
+func (s *azureTranslatorServer) writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	s.writeJSON(w, statusCode, translatortext.ErrorMessage{
+		Error: &translatortext.ErrorMessageError{
+			Code:    to.StringPtr(code),
+			Message: to.StringPtr(message),
+		},
+	})
+}
+
+func (s *azureTranslatorServer) writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,439 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
+)
+
+const (
+	JMdictFormatJMdict = "jmdict"
+	JMdictFormatEDICT2 = "edict2"
+)
+
+const (
+	jmdictCommonConfidence   = 1.0
+	jmdictUncommonConfidence = 0.5
+)
+
+type jmdictTranslationProvider struct {
+	// jaToEn is indexed by kanji and kana writings
+	jaToEn map[string][]service.TranslationCandidate
+	// enToJa is indexed by normalized glosses
+	enToJa map[string][]service.TranslationCandidate
+}
+
+type jmdictEntry struct {
+	kanji    []string
+	readings []string
+	common   bool
+	senses   []*jmdictSense
+}
+
+type jmdictSense struct {
+	pos     []domain.WordPos
+	glosses []string
+}
+
+type jmdictXMLEntry struct {
+	KEle []struct {
+		Keb   string   `xml:"keb"`
+		KePri []string `xml:"ke_pri"`
+	} `xml:"k_ele"`
+	REle []struct {
+		Reb   string   `xml:"reb"`
+		RePri []string `xml:"re_pri"`
+	} `xml:"r_ele"`
+	Sense []struct {
+		Pos   []string `xml:"pos"`
+		Gloss []struct {
+			Lang  string `xml:"lang,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"gloss"`
+	} `xml:"sense"`
+}
+
+// NewJMdictTranslationProviderFromFile loads a JMdict XML or EDICT2(UTF-8) file.
+func NewJMdictTranslationProviderFromFile(ctx context.Context, format, path string) (service.TranslationProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to open jmdict file. path: %s, err: %w", path, err)
+	}
+	defer f.Close()
+
+	return NewJMdictTranslationProvider(ctx, format, f)
+}
+
+// NewJMdictTranslationProvider returns the offline provider which answers en<->ja lookups from JMdict or EDICT2 entries.
+func NewJMdictTranslationProvider(ctx context.Context, format string, r io.Reader) (service.TranslationProvider, error) {
+	logger := log.FromContext(ctx)
+
+	var entries []*jmdictEntry
+	var err error
+	switch format {
+	case JMdictFormatJMdict:
+		entries, err = parseJMdictXML(r)
+	case JMdictFormatEDICT2:
+		entries, err = parseEDICT2(r)
+	default:
+		return nil, liberrors.Errorf("unsupported jmdict format. format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &jmdictTranslationProvider{
+		jaToEn: make(map[string][]service.TranslationCandidate),
+		enToJa: make(map[string][]service.TranslationCandidate),
+	}
+	for _, e := range entries {
+		p.addEntry(e)
+	}
+
+	logger.Infof("jmdict loaded. entries: %d, ja: %d, en: %d", len(entries), len(p.jaToEn), len(p.enToJa))
+
+	return p, nil
+}
+
+func (p *jmdictTranslationProvider) DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]service.TranslationCandidate, error) {
+	_, span := tracer.Start(ctx, "jmdictTranslationProvider.DictionaryLookup")
+	defer span.End()
+
+	switch {
+	case fromLang.String() == domain.Lang2EN.String() && toLang.String() == domain.Lang2JA.String():
+		return p.enToJa[normalizeJMdictGloss(text)], nil
+	case fromLang.String() == domain.Lang2JA.String() && toLang.String() == domain.Lang2EN.String():
+		return p.jaToEn[strings.TrimSpace(text)], nil
+	default:
+		return nil, nil
+	}
+}
+
+func (p *jmdictTranslationProvider) SupportedLanguagePairs() []domain.LanguagePair {
+	return []domain.LanguagePair{
+		{FromLang: domain.Lang2EN, ToLang: domain.Lang2JA},
+		{FromLang: domain.Lang2JA, ToLang: domain.Lang2EN},
+	}
+}
+
+func (p *jmdictTranslationProvider) DictionaryLookupBatch(ctx context.Context, texts []string, fromLang, toLang domain.Lang2) (map[string][]service.TranslationCandidate, error) {
+	results := make(map[string][]service.TranslationCandidate)
+	for _, text := range texts {
+		translations, err := p.DictionaryLookup(ctx, text, fromLang, toLang)
+		if err != nil {
+			return nil, err
+		}
+		results[text] = translations
+	}
+	return results, nil
+}
+
+func (p *jmdictTranslationProvider) addEntry(e *jmdictEntry) {
+	headwords := e.kanji
+	if len(headwords) == 0 {
+		headwords = e.readings
+	}
+	if len(headwords) == 0 {
+		return
+	}
+
+	writings := make([]string, 0, len(e.kanji)+len(e.readings))
+	writings = append(writings, e.kanji...)
+	writings = append(writings, e.readings...)
+
+	baseConfidence := jmdictUncommonConfidence
+	if e.common {
+		baseConfidence = jmdictCommonConfidence
+	}
+
+	for i, s := range e.senses {
+		// earlier senses are more relevant
+		confidence := baseConfidence / float64(i+1)
+		pos := s.pos
+		if len(pos) == 0 {
+			pos = []domain.WordPos{domain.PosOther}
+		}
+		for _, wordPos := range pos {
+			for _, gloss := range s.glosses {
+				for _, w := range writings {
+					p.jaToEn[w] = addJMdictCandidate(p.jaToEn[w], wordPos, gloss, confidence)
+				}
+
+				key := normalizeJMdictGloss(gloss)
+				if key == "" {
+					continue
+				}
+				p.enToJa[key] = addJMdictCandidate(p.enToJa[key], wordPos, headwords[0], confidence)
+			}
+		}
+	}
+}
+
+func addJMdictCandidate(candidates []service.TranslationCandidate, pos domain.WordPos, target string, confidence float64) []service.TranslationCandidate {
+	for i, c := range candidates {
+		if c.Pos == pos && c.Target == target {
+			if confidence > c.Confidence {
+				candidates[i].Confidence = confidence
+			}
+			return candidates
+		}
+	}
+	return append(candidates, service.TranslationCandidate{
+		Pos:        pos,
+		Target:     target,
+		Confidence: confidence,
+	})
+}
+
+// normalizeJMdictGloss removes notes in parentheses and the infinitive marker of verbs. eg. "to read (a book)" -> "read"
+func normalizeJMdictGloss(gloss string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range gloss {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+
+	v := strings.ToLower(strings.Join(strings.Fields(b.String()), " "))
+	return strings.TrimPrefix(v, "to ")
+}
+
+func parseJMdictXML(r io.Reader) ([]*jmdictEntry, error) {
+	decoder := xml.NewDecoder(r)
+	// JMdict defines part-of-speech as entities in its DTD. Keep them as they are(eg. "&n;").
+	decoder.Strict = false
+
+	entries := make([]*jmdictEntry, 0)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, liberrors.Errorf("failed to read jmdict xml. err: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "entry" {
+			continue
+		}
+
+		xmlEntry := jmdictXMLEntry{}
+		if err := decoder.DecodeElement(&xmlEntry, &start); err != nil {
+			return nil, liberrors.Errorf("failed to decode jmdict entry. err: %w", err)
+		}
+
+		entries = append(entries, xmlEntry.toEntry())
+	}
+
+	return entries, nil
+}
+
+func (e *jmdictXMLEntry) toEntry() *jmdictEntry {
+	entry := &jmdictEntry{}
+	for _, k := range e.KEle {
+		entry.kanji = append(entry.kanji, k.Keb)
+		entry.common = entry.common || len(k.KePri) > 0
+	}
+	for _, r := range e.REle {
+		entry.readings = append(entry.readings, r.Reb)
+		entry.common = entry.common || len(r.RePri) > 0
+	}
+
+	// part-of-speech of a sense applies to the following senses unless they have their own
+	var pos []domain.WordPos
+	for _, s := range e.Sense {
+		if len(s.Pos) > 0 {
+			pos = make([]domain.WordPos, 0, len(s.Pos))
+			for _, v := range s.Pos {
+				p, _ := parseJMdictPos(strings.Trim(v, "&;"))
+				pos = appendWordPos(pos, p)
+			}
+		}
+
+		sense := &jmdictSense{pos: pos}
+		for _, g := range s.Gloss {
+			if g.Lang != "" && g.Lang != "eng" {
+				continue
+			}
+			sense.glosses = append(sense.glosses, g.Value)
+		}
+		if len(sense.glosses) > 0 {
+			entry.senses = append(entry.senses, sense)
+		}
+	}
+	return entry
+}
+
+// parseEDICT2 parses lines like "漢字;感じ [かんじ] /(n) (1) kanji/(2) Chinese character/(P)/EntL1234X/".
+func parseEDICT2(r io.Reader) ([]*jmdictEntry, error) {
+	entries := make([]*jmdictEntry, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// the first line is the header of the file
+		if strings.HasPrefix(line, "　？？？") {
+			continue
+		}
+
+		entry, ok := parseEDICT2Line(line)
+		if !ok {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, liberrors.Errorf("failed to read edict2. err: %w", err)
+	}
+
+	return entries, nil
+}
+
+func parseEDICT2Line(line string) (*jmdictEntry, bool) {
+	idx := strings.Index(line, " /")
+	if idx < 0 {
+		return nil, false
+	}
+
+	entry := &jmdictEntry{}
+	head := strings.Fields(line[:idx])
+	if len(head) == 0 {
+		return nil, false
+	}
+	writings := splitEDICT2Headwords(head[0])
+	if len(head) > 1 && strings.HasPrefix(head[1], "[") {
+		entry.kanji = writings
+		entry.readings = splitEDICT2Headwords(strings.Trim(head[1], "[]"))
+	} else {
+		entry.readings = writings
+	}
+
+	var sense *jmdictSense
+	var pos []domain.WordPos
+	for _, field := range strings.Split(strings.Trim(line[idx+2:], "/"), "/") {
+		if field == "(P)" {
+			entry.common = true
+			continue
+		}
+		if strings.HasPrefix(field, "EntL") {
+			continue
+		}
+
+		gloss := strings.TrimSpace(field)
+		newSense := sense == nil
+		for strings.HasPrefix(gloss, "(") {
+			end := strings.Index(gloss, ")")
+			if end < 0 {
+				break
+			}
+			tag := gloss[1:end]
+			gloss = strings.TrimSpace(gloss[end+1:])
+
+			if _, err := strconv.Atoi(tag); err == nil {
+				newSense = true
+				continue
+			}
+			if tagPos, ok := parseEDICT2PosTag(tag); ok {
+				pos = tagPos
+				newSense = true
+			}
+		}
+		if gloss == "" {
+			continue
+		}
+
+		if newSense {
+			sense = &jmdictSense{pos: pos}
+			entry.senses = append(entry.senses, sense)
+		}
+		sense.glosses = append(sense.glosses, gloss)
+	}
+
+	return entry, true
+}
+
+func splitEDICT2Headwords(v string) []string {
+	words := make([]string, 0)
+	for _, w := range strings.Split(v, ";") {
+		// remove tags such as "(P)" or "(ik)"
+		if idx := strings.Index(w, "("); idx >= 0 {
+			w = w[:idx]
+		}
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// parseEDICT2PosTag parses tags like "n,vs". Tags which are not part-of-speech such as "uk" are ignored.
+func parseEDICT2PosTag(tag string) ([]domain.WordPos, bool) {
+	pos := make([]domain.WordPos, 0)
+	for _, v := range strings.Split(tag, ",") {
+		p, ok := parseJMdictPos(v)
+		if !ok {
+			return nil, false
+		}
+		pos = appendWordPos(pos, p)
+	}
+	return pos, true
+}
+
+// parseJMdictPos maps the part-of-speech entity names of JMdict onto WordPos.
+// https://www.edrdg.org/jmdictdb/cgi-bin/edhelp.py?svc=jmdict&sid=#kw_pos
+func parseJMdictPos(v string) (domain.WordPos, bool) {
+	switch v {
+	case "n", "n-adv", "n-t", "n-pr", "n-pref", "n-suf":
+		return domain.PosNoun, true
+	case "pn":
+		return domain.PosPron, true
+	case "adj-pn":
+		return domain.PosDet, true
+	case "adv", "adv-to":
+		return domain.PosAdv, true
+	case "conj":
+		return domain.PosConj, true
+	case "aux-v":
+		return domain.PosModal, true
+	case "aux", "aux-adj", "cop", "cop-da", "ctr", "exp", "int", "num", "pref", "prt", "suf", "unc":
+		return domain.PosOther, true
+	case "vi", "vt", "vk", "vn", "vr", "vz", "v-unspec":
+		return domain.PosVerb, true
+	}
+
+	if strings.HasPrefix(v, "adj-") {
+		return domain.PosAdj, true
+	}
+	for _, prefix := range []string{"v1", "v2", "v4", "v5", "vs"} {
+		if strings.HasPrefix(v, prefix) {
+			return domain.PosVerb, true
+		}
+	}
+	return domain.PosOther, false
+}
+
+func appendWordPos(pos []domain.WordPos, p domain.WordPos) []domain.WordPos {
+	for _, v := range pos {
+		if v == p {
+			return pos
+		}
+	}
+	return append(pos, p)
+}
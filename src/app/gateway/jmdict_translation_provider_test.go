@@ -0,0 +1,152 @@
+package gateway_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+const jmdictXMLForTest = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE JMdict [
+<!ENTITY n "noun (common) (futsuumeishi)">
+<!ENTITY vs "noun or participle which takes the aux. verb suru">
+<!ENTITY v5r "Godan verb with 'ru' ending">
+]>
+<JMdict>
+<entry>
+<ent_seq>1522150</ent_seq>
+<k_ele><keb>本</keb><ke_pri>ichi1</ke_pri></k_ele>
+<r_ele><reb>ほん</reb><re_pri>ichi1</re_pri></r_ele>
+<sense><pos>&n;</pos><gloss>book</gloss><gloss xml:lang="ger">Buch</gloss></sense>
+<sense><gloss>volume</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1269320</ent_seq>
+<k_ele><keb>予約</keb><ke_pri>news1</ke_pri></k_ele>
+<r_ele><reb>よやく</reb></r_ele>
+<sense><pos>&n;</pos><pos>&vs;</pos><gloss>reservation</gloss><gloss>booking</gloss></sense>
+<sense><gloss>to book (a room)</gloss></sense>
+</entry>
+<entry>
+<ent_seq>1919720</ent_seq>
+<k_ele><keb>走る</keb></k_ele>
+<r_ele><reb>はしる</reb></r_ele>
+<sense><pos>&v5r;</pos><gloss>to run</gloss></sense>
+</entry>
+</JMdict>
+`
+
+const edict2ForTest = `　？？？ /EDICT, EDICT_SUB(P), EDICT2 Japanese-English Electronic Dictionary Files/
+本 [ほん] /(n) (1) book/(2) volume/(P)/EntL1522150X/
+予約 [よやく] /(n,vs) reservation/booking/(P)/EntL1269320X/
+走る [はしる] /(v5r,vi) (1) to run/(2) (uk) to travel (movement of vehicles)/EntL1919720X/
+`
+
+func Test_jmdictTranslationProvider_DictionaryLookup(t *testing.T) {
+	bg := context.Background()
+
+	for _, format := range []struct {
+		name    string
+		content string
+	}{
+		{name: gateway.JMdictFormatJMdict, content: jmdictXMLForTest},
+		{name: gateway.JMdictFormatEDICT2, content: edict2ForTest},
+	} {
+		format := format
+		t.Run(format.name, func(t *testing.T) {
+			// given
+			provider, err := gateway.NewJMdictTranslationProvider(bg, format.name, strings.NewReader(format.content))
+			require.NoError(t, err)
+
+			tests := []struct {
+				name     string
+				text     string
+				fromLang domain.Lang2
+				toLang   domain.Lang2
+				want     []service.TranslationCandidate
+			}{
+				{
+					name:     "ja to en",
+					text:     "ほん",
+					fromLang: domain.Lang2JA,
+					toLang:   domain.Lang2EN,
+					want: []service.TranslationCandidate{
+						{Pos: domain.PosNoun, Target: "book", Confidence: 1},
+						{Pos: domain.PosNoun, Target: "volume", Confidence: 0.5},
+					},
+				},
+				{
+					name:     "en to ja",
+					text:     "Volume",
+					fromLang: domain.Lang2EN,
+					toLang:   domain.Lang2JA,
+					want: []service.TranslationCandidate{
+						{Pos: domain.PosNoun, Target: "本", Confidence: 0.5},
+					},
+				},
+				{
+					name:     "verb",
+					text:     "run",
+					fromLang: domain.Lang2EN,
+					toLang:   domain.Lang2JA,
+					want: []service.TranslationCandidate{
+						{Pos: domain.PosVerb, Target: "走る", Confidence: 0.5},
+					},
+				},
+				{
+					name:     "not found",
+					text:     "pen",
+					fromLang: domain.Lang2EN,
+					toLang:   domain.Lang2JA,
+					want:     nil,
+				},
+			}
+			for _, tt := range tests {
+				tt := tt
+				t.Run(tt.name, func(t *testing.T) {
+					// when
+					got, err := provider.DictionaryLookup(bg, tt.text, tt.fromLang, tt.toLang)
+					// then
+					require.NoError(t, err)
+					assert.Equal(t, tt.want, got)
+				})
+			}
+		})
+	}
+}
+
+func Test_jmdictTranslationProvider_DictionaryLookup_pos(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	provider, err := gateway.NewJMdictTranslationProvider(bg, gateway.JMdictFormatJMdict, strings.NewReader(jmdictXMLForTest))
+	require.NoError(t, err)
+
+	// when
+	got, err := provider.DictionaryLookup(bg, "booking", domain.Lang2EN, domain.Lang2JA)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "予約", Confidence: 1},
+		{Pos: domain.PosVerb, Target: "予約", Confidence: 1},
+	}, got)
+
+	// when
+	got, err = provider.DictionaryLookup(bg, "book", domain.Lang2EN, domain.Lang2JA)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "本", Confidence: 1},
+		{Pos: domain.PosNoun, Target: "予約", Confidence: 0.5},
+		{Pos: domain.PosVerb, Target: "予約", Confidence: 0.5},
+	}, got)
+}
@@ -2,28 +2,50 @@ package gateway
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
 )
 
 type repositoryFactory struct {
-	db         *gorm.DB
-	driverName string
+	db               *gorm.DB
+	driverName       string
+	negativeCacheTTL time.Duration
 }
 
-func NewRepositoryFactory(ctx context.Context, db *gorm.DB, driverName string) (service.RepositoryFactory, error) {
+func NewRepositoryFactory(ctx context.Context, db *gorm.DB, driverName string, negativeCacheTTL time.Duration) (service.RepositoryFactory, error) {
 	return &repositoryFactory{
-		db:         db,
-		driverName: driverName,
+		db:               db,
+		driverName:       driverName,
+		negativeCacheTTL: negativeCacheTTL,
 	}, nil
 }
 
 func (f *repositoryFactory) NewAzureTranslationRepository(ctx context.Context) service.AzureTranslationRepository {
-	return NewAzureTranslationRepository(f.db)
+	return NewAzureTranslationRepository(f.db, f.negativeCacheTTL, domain.Lang2EN)
 }
 
 func (f *repositoryFactory) NewCustomTranslationRepository(ctx context.Context) service.CustomTranslationRepository {
 	return NewCustomTranslationRepository(f.db)
 }
+
+func (f *repositoryFactory) NewAzureExampleRepository(ctx context.Context) service.AzureExampleRepository {
+	return NewAzureExampleRepository(f.db)
+}
+
+func (f *repositoryFactory) NewAzureTextTranslationRepository(ctx context.Context) service.AzureTextTranslationRepository {
+	return NewAzureTextTranslationRepository(f.db)
+}
+
+func (f *repositoryFactory) NewTranslationCacheRepository(ctx context.Context, providerName string, fromLang domain.Lang2) (service.TranslationCacheRepository, error) {
+	switch providerName {
+	case service.TranslationProviderAzure:
+		return NewAzureTranslationRepository(f.db, f.negativeCacheTTL, fromLang), nil
+	default:
+		return nil, liberrors.Errorf("cache repository is not found. provider: %s, err: %w", providerName, service.ErrTranslationProviderNotFound)
+	}
+}
@@ -0,0 +1,74 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+)
+
+type RepositoryFactoryFunc func(ctx context.Context, db *gorm.DB) (service.RepositoryFactory, error)
+
+type transactionManager struct {
+	db  *gorm.DB
+	rff RepositoryFactoryFunc
+}
+
+func NewTransactionManager(db *gorm.DB, rff RepositoryFactoryFunc) service.TransactionManager {
+	return &transactionManager{
+		db:  db,
+		rff: rff,
+	}
+}
+
+// Do calls fn in a transaction. The hooks registered with afterCommit through the context passed to rff run after the transaction ends.
+// They also run after a rollback because the repositories may have cached the rows read in the transaction.
+func (t *transactionManager) Do(ctx context.Context, fn func(rf service.RepositoryFactory) error) error {
+	hooks := &afterCommitHooks{}
+	defer hooks.run()
+
+	hookCtx := context.WithValue(ctx, afterCommitHooksKey{}, hooks)
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rf, err := t.rff(hookCtx, tx)
+		if err != nil {
+			return err
+		}
+		return fn(rf)
+	})
+}
+
+type afterCommitHooksKey struct{}
+
+type afterCommitHooks struct {
+	mu    sync.Mutex
+	hooks []func()
+}
+
+func (h *afterCommitHooks) add(hook func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks = append(h.hooks, hook)
+}
+
+func (h *afterCommitHooks) run() {
+	h.mu.Lock()
+	hooks := h.hooks
+	h.hooks = nil
+	h.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
+// afterCommit returns the function which defers hooks until the transaction of ctx ends.
+// Outside of transactions it calls hooks immediately.
+func afterCommit(ctx context.Context) func(hook func()) {
+	hooks, ok := ctx.Value(afterCommitHooksKey{}).(*afterCommitHooks)
+	if !ok {
+		return func(hook func()) { hook() }
+	}
+	return hooks.add
+}
@@ -0,0 +1,23 @@
+package service
+
+import "context"
+
+type contextKey int
+
+const (
+	actorKey contextKey = iota
+)
+
+// WithActor returns the context which has the actor. The actor is the authenticated principal who changes data.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// ActorFromContext returns the actor of the context. It returns "" if the context has no actors.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorKey).(string)
+	if !ok {
+		return ""
+	}
+	return actor
+}
@@ -0,0 +1,30 @@
+//go:generate mockery --output mock --name AzureExampleRepository
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+)
+
+var ErrExampleNotFound = errors.New("example not found")
+var ErrAzureExampleAlreadyExists = errors.New("azure example already exists")
+
+// AzureExample is an example sentence of a pair of a text and its translation
+type AzureExample struct {
+	Source domain.ExampleSentence
+	Target domain.ExampleSentence
+}
+
+func (e *AzureExample) ToExample(lang2 domain.Lang2, text, translated string) (domain.Example, error) {
+	return domain.NewExample(lang2, text, translated, e.Source, e.Target)
+}
+
+// AzureExampleRepository caches the examples of azure. Pairs which have no examples are cached as well.
+type AzureExampleRepository interface {
+	Add(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string, examples []AzureExample) error
+
+	// Find returns the examples in the order of azure. ErrExampleNotFound is returned if the pair is not cached.
+	Find(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string) ([]AzureExample, error)
+}
@@ -0,0 +1,33 @@
+//go:generate mockery --output mock --name AzureTextTranslationRepository
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+)
+
+var ErrTextTranslationNotFound = errors.New("text translation not found")
+var ErrAzureTextTranslationAlreadyExists = errors.New("azure text translation already exists")
+
+// AzureTextTranslation is the result of the translation of a free text.
+// FromLang is the detected language if the language of the text was not specified.
+type AzureTextTranslation struct {
+	FromLang          domain.Lang2
+	Translated        string
+	Detected          bool
+	DetectedLangScore float64
+}
+
+func (t *AzureTextTranslation) ToTextTranslation(toLang domain.Lang2, text string) (domain.TextTranslation, error) {
+	return domain.NewTextTranslation(text, t.FromLang, toLang, t.Translated, t.Detected, t.DetectedLangScore)
+}
+
+// AzureTextTranslationRepository caches the translations of free texts. fromLang is nil if the language of the text was to be detected.
+type AzureTextTranslationRepository interface {
+	Add(ctx context.Context, fromLang, toLang domain.Lang2, text string, result *AzureTextTranslation) error
+
+	// Find returns ErrTextTranslationNotFound if the text is not cached
+	Find(ctx context.Context, fromLang, toLang domain.Lang2, text string) (*AzureTextTranslation, error)
+}
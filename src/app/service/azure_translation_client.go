@@ -1,12 +1,49 @@
 //go:generate mockery --output mock --name AzureTranslationClient
+//go:generate mockery --output mock --name ExampleProvider
+//go:generate mockery --output mock --name TextTranslator
 package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 )
 
 type AzureTranslationClient interface {
-	DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]AzureTranslation, error)
+	DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]TranslationCandidate, error)
+
+	DictionaryLookupBatch(ctx context.Context, texts []string, fromLang, toLang domain.Lang2) (map[string][]TranslationCandidate, error)
+
+	Examples(ctx context.Context, text, translated string, fromLang, toLang domain.Lang2) ([]AzureExample, error)
+
+	Translate(ctx context.Context, text string, fromLang, toLang domain.Lang2) (*AzureTextTranslation, error)
+
+	// SupportedLanguagePairs returns the pairs of the languages which the dictionary and the examples are available in
+	SupportedLanguagePairs() []domain.LanguagePair
+
+	// SupportedTextLanguagePairs returns the pairs of the languages which free texts can be translated between
+	SupportedTextLanguagePairs() []domain.LanguagePair
+}
+
+var ErrExampleProviderNotFound = errors.New("example provider not found")
+
+// ExampleProvider is a dictionary service which example sentences of a text and its translation are looked up from.
+type ExampleProvider interface {
+	Examples(ctx context.Context, text, translated string, fromLang, toLang domain.Lang2) ([]AzureExample, error)
+
+	// SupportedLanguagePairs returns the pairs of the languages which examples can be looked up in
+	SupportedLanguagePairs() []domain.LanguagePair
+}
+
+var ErrTextTranslatorNotFound = errors.New("text translator not found")
+
+// TextTranslator is a service which translates free texts such as sentences.
+type TextTranslator interface {
+	// Translate detects the language of the text if fromLang is nil
+	Translate(ctx context.Context, text string, fromLang, toLang domain.Lang2) (*AzureTextTranslation, error)
+
+	// SupportedTextLanguagePairs returns the pairs of the languages which free texts can be translated between.
+	// They are independent of the pairs of the dictionary.
+	SupportedTextLanguagePairs() []domain.LanguagePair
 }
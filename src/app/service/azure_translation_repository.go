@@ -11,16 +11,6 @@ import (
 
 var ErrAzureTranslationAlreadyExists = errors.New("azure translation already exists")
 
-type AzureTranslation struct {
-	Pos        domain.WordPos
-	Target     string
-	Confidence float64
-}
-
-func (t *AzureTranslation) ToTranslation(lang2 domain.Lang2, text string) (domain.Translation, error) {
-	return domain.NewTranslation(1, time.Now(), time.Now(), text, t.Pos, lang2, t.Target, "azure")
-}
-
 type TranslationSearchCondition struct {
 	PageNo   int
 	PageSize int
@@ -28,13 +18,27 @@ type TranslationSearchCondition struct {
 
 type TranslationSearchResult struct {
 	TotalCount int64
-	Results    [][]AzureTranslation
+	Results    [][]TranslationCandidate
+}
+
+// NegativeCacheEntry records that the provider has no translations of the text until ExpiresAt.
+type NegativeCacheEntry struct {
+	Lang2     domain.Lang2
+	Text      string
+	ExpiresAt time.Time
+}
+
+type NegativeCacheSearchResult struct {
+	TotalCount int64
+	Results    []NegativeCacheEntry
 }
 
 type AzureTranslationRepository interface {
-	Add(ctx context.Context, lang2 domain.Lang2, text string, result []AzureTranslation) error
+	Add(ctx context.Context, lang2 domain.Lang2, text string, result []TranslationCandidate) error
 
-	Find(ctx context.Context, lang2 domain.Lang2, text string) ([]AzureTranslation, error)
+	Find(ctx context.Context, lang2 domain.Lang2, text string) ([]TranslationCandidate, error)
+
+	FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]TranslationCandidate, error)
 
 	FindByTextAndPos(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error)
 
@@ -42,5 +46,30 @@ type AzureTranslationRepository interface {
 
 	FindByFirstLetter(ctx context.Context, lang2 domain.Lang2, firstLetter string) ([]domain.Translation, error)
 
+	// FindByLang2InBatches calls fn with the texts and their translations, batchSize texts at a time in text order
+	FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func(texts []string, translations []domain.Translation) error) error
+
 	Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error)
+
+	// FindByTarget returns the entries which have the translations equal to target, or starting with target if prefix is true.
+	// The results of each entry contain only the matched translations. Negative entries are not returned.
+	FindByTarget(ctx context.Context, lang2 domain.Lang2, target string, prefix bool) ([]TranslationCacheEntry, error)
+
+	// FindStale returns at most limit entries which have not been refreshed since refreshedBefore, the oldest first. Negative entries are not returned.
+	FindStale(ctx context.Context, refreshedBefore time.Time, limit int) ([]TranslationCacheEntry, error)
+
+	// Refresh replaces the translations of the text and updates its refreshed time
+	Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []TranslationCandidate) error
+
+	// Touch updates the refreshed time of the text without changing its translations
+	Touch(ctx context.Context, lang2 domain.Lang2, text string) error
+
+	// AddNegative records that azure has no translations of the text. Expired entries are replaced.
+	AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error
+
+	// FindNegatives returns the negative entries including expired ones in text order
+	FindNegatives(ctx context.Context, lang2 domain.Lang2, condition *TranslationSearchCondition) (*NegativeCacheSearchResult, error)
+
+	// RemoveNegatives removes the negative entries of the text. All the negative entries of lang2 are removed if text is empty.
+	RemoveNegatives(ctx context.Context, lang2 domain.Lang2, text string) (int64, error)
 }
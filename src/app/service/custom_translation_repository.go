@@ -4,6 +4,9 @@ package service
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	libD "github.com/kujilabo/cocotola-translator-api/src/lib/domain"
@@ -12,26 +15,148 @@ import (
 // var ErrCustomTranslationNotFound = errors.New("azure translation not found")
 // var ErrCustomTranslationAlreadyExists = errors.New("azure translation already exists")
 
+// senseGlossSeparator joins the glosses of the senses into the translated text
+const senseGlossSeparator = ", "
+
+// normalizeSenses sorts the senses by display order and numbers them from 1.
+// The senses with the same order keep their order in the list.
+func normalizeSenses(senses []domain.Sense) []domain.Sense {
+	results := make([]domain.Sense, len(senses))
+	copy(results, senses)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Order < results[j].Order
+	})
+	for i := range results {
+		results[i].Order = i + 1
+	}
+	return results
+}
+
+func joinSenseGlosses(senses []domain.Sense) string {
+	glosses := make([]string, len(senses))
+	for i, s := range senses {
+		glosses[i] = s.Gloss
+	}
+	return strings.Join(glosses, senseGlossSeparator)
+}
+
+// normalizeAnnotation lowercases the labels and the tags, and removes the empty and duplicated ones
+func normalizeAnnotation(annotation domain.TranslationAnnotation) domain.TranslationAnnotation {
+	normalizeTerms := func(terms []string) []string {
+		results := make([]string, 0, len(terms))
+		found := make(map[string]bool)
+		for _, term := range terms {
+			term = strings.ToLower(strings.TrimSpace(term))
+			if term == "" || found[term] {
+				continue
+			}
+			found[term] = true
+			results = append(results, term)
+		}
+		return results
+	}
+
+	return domain.TranslationAnnotation{
+		Note:   strings.TrimSpace(annotation.Note),
+		Labels: normalizeTerms(annotation.Labels),
+		Tags:   normalizeTerms(annotation.Tags),
+		Level:  strings.ToUpper(annotation.Level),
+	}
+}
+
+// TranslationAnnotationUpdate has the fields of the annotation to be replaced. The current values of the nil fields are kept.
+type TranslationAnnotationUpdate struct {
+	Note   *string
+	Labels *[]string
+	Tags   *[]string
+	Level  *string
+}
+
+// NewTranslationAnnotationUpdate returns the update which replaces all the fields with annotation
+func NewTranslationAnnotationUpdate(annotation domain.TranslationAnnotation) TranslationAnnotationUpdate {
+	return TranslationAnnotationUpdate{
+		Note:   &annotation.Note,
+		Labels: &annotation.Labels,
+		Tags:   &annotation.Tags,
+		Level:  &annotation.Level,
+	}
+}
+
+// Apply returns current whose fields are replaced with the non-nil fields of the update
+func (u TranslationAnnotationUpdate) Apply(current domain.TranslationAnnotation) domain.TranslationAnnotation {
+	if u.Note != nil {
+		current.Note = *u.Note
+	}
+	if u.Labels != nil {
+		current.Labels = *u.Labels
+	}
+	if u.Tags != nil {
+		current.Tags = *u.Tags
+	}
+	if u.Level != nil {
+		current.Level = *u.Level
+	}
+	return current
+}
+
+// normalizeAnnotationUpdate normalizes the non-nil fields of the update in the same way as normalizeAnnotation
+func normalizeAnnotationUpdate(update TranslationAnnotationUpdate) (TranslationAnnotationUpdate, domain.TranslationAnnotation) {
+	normalized := normalizeAnnotation(update.Apply(domain.TranslationAnnotation{}))
+	result := TranslationAnnotationUpdate{}
+	if update.Note != nil {
+		result.Note = &normalized.Note
+	}
+	if update.Labels != nil {
+		result.Labels = &normalized.Labels
+	}
+	if update.Tags != nil {
+		result.Tags = &normalized.Tags
+	}
+	if update.Level != nil {
+		result.Level = &normalized.Level
+	}
+	return result, normalized
+}
+
 type TranslationAddParameter interface {
 	GetText() string
 	GetPos() domain.WordPos
 	GetLang2() domain.Lang2
+	// GetTranslated returns the glosses of the senses joined with commas
 	GetTranslated() string
+	// GetSenses returns the senses in display order
+	GetSenses() []domain.Sense
+	GetAnnotation() domain.TranslationAnnotation
 }
 
 type translationAddParameter struct {
 	Text       string `validate:"required"`
 	Pos        domain.WordPos
 	Lang2      domain.Lang2
-	Translated string
+	Translated string         `validate:"required,max=100"`
+	Senses     []domain.Sense `validate:"required,min=1,dive"`
+	Annotation domain.TranslationAnnotation
 }
 
+// NewTransalationAddParameter returns the parameter of the translation which has the only sense.
+// An empty translated is invalid, as with REST and NewTransaltionUpdateParameter.
 func NewTransalationAddParameter(text string, pos domain.WordPos, lang2 domain.Lang2, translated string) (TranslationAddParameter, error) {
+	return NewTransalationAddParameterWithSenses(text, pos, lang2, []domain.Sense{{Gloss: translated}})
+}
+
+func NewTransalationAddParameterWithSenses(text string, pos domain.WordPos, lang2 domain.Lang2, senses []domain.Sense) (TranslationAddParameter, error) {
+	return NewTransalationAddParameterWithAnnotation(text, pos, lang2, senses, domain.TranslationAnnotation{})
+}
+
+func NewTransalationAddParameterWithAnnotation(text string, pos domain.WordPos, lang2 domain.Lang2, senses []domain.Sense, annotation domain.TranslationAnnotation) (TranslationAddParameter, error) {
+	senses = normalizeSenses(senses)
 	m := &translationAddParameter{
 		Text:       text,
 		Pos:        pos,
 		Lang2:      lang2,
-		Translated: translated,
+		Translated: joinSenseGlosses(senses),
+		Senses:     senses,
+		Annotation: normalizeAnnotation(annotation),
 	}
 
 	return m, libD.Validator.Struct(m)
@@ -53,17 +178,59 @@ func (p *translationAddParameter) GetTranslated() string {
 	return p.Translated
 }
 
+func (p *translationAddParameter) GetSenses() []domain.Sense {
+	return p.Senses
+}
+
+func (p *translationAddParameter) GetAnnotation() domain.TranslationAnnotation {
+	return p.Annotation
+}
+
 type TranslationUpdateParameter interface {
+	// GetTranslated returns the glosses of the senses joined with commas
 	GetTranslated() string
+	// GetSenses returns the senses in display order
+	GetSenses() []domain.Sense
+	// GetAnnotationUpdate returns the fields of the annotation which replace the current ones
+	GetAnnotationUpdate() TranslationAnnotationUpdate
+	// GetVersion returns the version which the translation is expected to have. 0 means any version.
+	GetVersion() int
 }
 
 type translationUpdateParameter struct {
-	Translated string `validate:"required"`
+	Translated       string         `validate:"required,max=100"`
+	Senses           []domain.Sense `validate:"required,min=1,dive"`
+	AnnotationUpdate TranslationAnnotationUpdate
+	// Annotation is AnnotationUpdate applied to the empty annotation. It is validated instead of AnnotationUpdate.
+	Annotation domain.TranslationAnnotation
+	Version    int `validate:"gte=0"`
+}
+
+// NewTransaltionUpdateParameter returns the parameter of the translation which has the only sense
+func NewTransaltionUpdateParameter(translated string, version int) (TranslationUpdateParameter, error) {
+	return NewTransaltionUpdateParameterWithSenses([]domain.Sense{{Gloss: translated}}, version)
+}
+
+// NewTransaltionUpdateParameterWithSenses returns the parameter which keeps the current annotation
+func NewTransaltionUpdateParameterWithSenses(senses []domain.Sense, version int) (TranslationUpdateParameter, error) {
+	return NewTransaltionUpdateParameterWithAnnotationUpdate(senses, TranslationAnnotationUpdate{}, version)
+}
+
+// NewTransaltionUpdateParameterWithAnnotation returns the parameter which replaces all the fields of the annotation
+func NewTransaltionUpdateParameterWithAnnotation(senses []domain.Sense, annotation domain.TranslationAnnotation, version int) (TranslationUpdateParameter, error) {
+	return NewTransaltionUpdateParameterWithAnnotationUpdate(senses, NewTranslationAnnotationUpdate(annotation), version)
 }
 
-func NewTransaltionUpdateParameter(translated string) (TranslationUpdateParameter, error) {
+// NewTransaltionUpdateParameterWithAnnotationUpdate returns the parameter which replaces only the non-nil fields of the annotation
+func NewTransaltionUpdateParameterWithAnnotationUpdate(senses []domain.Sense, update TranslationAnnotationUpdate, version int) (TranslationUpdateParameter, error) {
+	senses = normalizeSenses(senses)
+	update, annotation := normalizeAnnotationUpdate(update)
 	m := &translationUpdateParameter{
-		Translated: translated,
+		Translated:       joinSenseGlosses(senses),
+		Senses:           senses,
+		AnnotationUpdate: update,
+		Annotation:       annotation,
+		Version:          version,
 	}
 
 	return m, libD.Validator.Struct(m)
@@ -73,12 +240,76 @@ func (p *translationUpdateParameter) GetTranslated() string {
 	return p.Translated
 }
 
+func (p *translationUpdateParameter) GetSenses() []domain.Sense {
+	return p.Senses
+}
+
+func (p *translationUpdateParameter) GetAnnotationUpdate() TranslationAnnotationUpdate {
+	return p.AnnotationUpdate
+}
+
+func (p *translationUpdateParameter) GetVersion() int {
+	return p.Version
+}
+
+const (
+	CustomTranslationActionAdd     = "add"
+	CustomTranslationActionUpdate  = "update"
+	CustomTranslationActionRemove  = "remove"
+	CustomTranslationActionRestore = "restore"
+)
+
+// CustomTranslationHistory is a change of the custom translation.
+// Version is the version after the change, and NewSenses are the senses after the change.
+type CustomTranslationHistory struct {
+	Version       int
+	Action        string
+	Actor         string
+	OldTranslated string
+	NewTranslated string
+	NewSenses     []domain.Sense
+	CreatedAt     time.Time
+}
+
+// CustomTranslationSearchCondition filters the custom translations. Empty Tag and Level match all the translations.
+type CustomTranslationSearchCondition struct {
+	Tag      string
+	Level    string
+	PageNo   int
+	PageSize int
+}
+
+type CustomTranslationSearchResult struct {
+	TotalCount int64
+	Results    []domain.Translation
+}
+
+// CustomTranslationRepository stores the custom translations.
+// Removed translations are kept as disabled ones, which suppress the translations of the providers for the same text and pos.
+// The find methods return disabled translations as well, and the callers skip them with IsDisabled.
 type CustomTranslationRepository interface {
+	// Add adds the translation. A disabled translation of the text and pos is enabled with the new translated text.
+	// Its version follows the last version in the history so that versions are not reused.
 	Add(ctx context.Context, param TranslationAddParameter) error
 
+	// Update updates the translation, enables it if it is disabled and increments its version.
+	// It returns ErrVersionConflict if the version of the translation is not param.GetVersion().
 	Update(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, param TranslationUpdateParameter) error
 
-	Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error
+	// Remove disables the translation. A disabled translation without translated text is added if the text and pos have no custom translations
+	// so that the translations of the providers are suppressed.
+	// It returns ErrVersionConflict if version is not 0 and the version of the translation is not version.
+	Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error
+
+	// Restore enables the disabled translation. The disabled translation without translated text is deleted.
+	// It returns ErrTranslationNotFound if the translation is not disabled, and ErrVersionConflict if version is not 0 and the version of the translation is not version.
+	Restore(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error
+
+	// FindDisabled returns the disabled translations in (text, pos) order
+	FindDisabled(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error)
+
+	// FindByCondition returns the page of the enabled translations which have the tag and the level in (text, pos) order
+	FindByCondition(ctx context.Context, lang2 domain.Lang2, condition *CustomTranslationSearchCondition) (*CustomTranslationSearchResult, error)
 
 	FindByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error)
 
@@ -86,5 +317,17 @@ type CustomTranslationRepository interface {
 
 	FindByFirstLetter(ctx context.Context, lang2 domain.Lang2, firstLetter string) ([]domain.Translation, error)
 
+	FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) ([]domain.Translation, error)
+
+	// FindByTranslated returns the translations equal to translated, or starting with translated if prefix is true
+	FindByTranslated(ctx context.Context, lang2 domain.Lang2, translated string, prefix bool) ([]domain.Translation, error)
+
+	// FindByLang2InBatches calls fn with batchSize translations at a time in (text, pos) order
+	FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func(translations []domain.Translation) error) error
+
 	Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error)
+
+	// FindHistory returns the changes of the translation in descending order of the version.
+	// Add, Update and Remove record the changes with the actor of the context.
+	FindHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]CustomTranslationHistory, error)
 }
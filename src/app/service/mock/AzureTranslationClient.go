@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
-	mock "github.com/stretchr/testify/mock"
-
 	service "github.com/kujilabo/cocotola-translator-api/src/app/service"
+	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
 )
@@ -19,15 +18,116 @@ type AzureTranslationClient struct {
 }
 
 // DictionaryLookup provides a mock function with given fields: ctx, text, fromLang, toLang
-func (_m *AzureTranslationClient) DictionaryLookup(ctx context.Context, text string, fromLang domain.Lang2, toLang domain.Lang2) ([]service.AzureTranslation, error) {
+func (_m *AzureTranslationClient) DictionaryLookup(ctx context.Context, text string, fromLang domain.Lang2, toLang domain.Lang2) ([]service.TranslationCandidate, error) {
+	ret := _m.Called(ctx, text, fromLang, toLang)
+
+	var r0 []service.TranslationCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Lang2, domain.Lang2) []service.TranslationCandidate); ok {
+		r0 = rf(ctx, text, fromLang, toLang)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.TranslationCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.Lang2, domain.Lang2) error); ok {
+		r1 = rf(ctx, text, fromLang, toLang)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DictionaryLookupBatch provides a mock function with given fields: ctx, texts, fromLang, toLang
+func (_m *AzureTranslationClient) DictionaryLookupBatch(ctx context.Context, texts []string, fromLang domain.Lang2, toLang domain.Lang2) (map[string][]service.TranslationCandidate, error) {
+	ret := _m.Called(ctx, texts, fromLang, toLang)
+
+	var r0 map[string][]service.TranslationCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, []string, domain.Lang2, domain.Lang2) map[string][]service.TranslationCandidate); ok {
+		r0 = rf(ctx, texts, fromLang, toLang)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]service.TranslationCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, domain.Lang2, domain.Lang2) error); ok {
+		r1 = rf(ctx, texts, fromLang, toLang)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Examples provides a mock function with given fields: ctx, text, translated, fromLang, toLang
+func (_m *AzureTranslationClient) Examples(ctx context.Context, text string, translated string, fromLang domain.Lang2, toLang domain.Lang2) ([]service.AzureExample, error) {
+	ret := _m.Called(ctx, text, translated, fromLang, toLang)
+
+	var r0 []service.AzureExample
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.Lang2, domain.Lang2) []service.AzureExample); ok {
+		r0 = rf(ctx, text, translated, fromLang, toLang)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.AzureExample)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, domain.Lang2, domain.Lang2) error); ok {
+		r1 = rf(ctx, text, translated, fromLang, toLang)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SupportedLanguagePairs provides a mock function with given fields:
+func (_m *AzureTranslationClient) SupportedLanguagePairs() []domain.LanguagePair {
+	ret := _m.Called()
+
+	var r0 []domain.LanguagePair
+	if rf, ok := ret.Get(0).(func() []domain.LanguagePair); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LanguagePair)
+		}
+	}
+
+	return r0
+}
+
+// SupportedTextLanguagePairs provides a mock function with given fields:
+func (_m *AzureTranslationClient) SupportedTextLanguagePairs() []domain.LanguagePair {
+	ret := _m.Called()
+
+	var r0 []domain.LanguagePair
+	if rf, ok := ret.Get(0).(func() []domain.LanguagePair); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LanguagePair)
+		}
+	}
+
+	return r0
+}
+
+// Translate provides a mock function with given fields: ctx, text, fromLang, toLang
+func (_m *AzureTranslationClient) Translate(ctx context.Context, text string, fromLang domain.Lang2, toLang domain.Lang2) (*service.AzureTextTranslation, error) {
 	ret := _m.Called(ctx, text, fromLang, toLang)
 
-	var r0 []service.AzureTranslation
-	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Lang2, domain.Lang2) []service.AzureTranslation); ok {
+	var r0 *service.AzureTextTranslation
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Lang2, domain.Lang2) *service.AzureTextTranslation); ok {
 		r0 = rf(ctx, text, fromLang, toLang)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]service.AzureTranslation)
+			r0 = ret.Get(0).(*service.AzureTextTranslation)
 		}
 	}
 
@@ -4,11 +4,11 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
-	mock "github.com/stretchr/testify/mock"
-
 	service "github.com/kujilabo/cocotola-translator-api/src/app/service"
+	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
 )
@@ -19,11 +19,11 @@ type AzureTranslationRepository struct {
 }
 
 // Add provides a mock function with given fields: ctx, lang2, text, result
-func (_m *AzureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.AzureTranslation) error {
+func (_m *AzureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
 	ret := _m.Called(ctx, lang2, text, result)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, []service.AzureTranslation) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, []service.TranslationCandidate) error); ok {
 		r0 = rf(ctx, lang2, text, result)
 	} else {
 		r0 = ret.Error(0)
@@ -32,6 +32,20 @@ func (_m *AzureTranslationRepository) Add(ctx context.Context, lang2 domain.Lang
 	return r0
 }
 
+// AddNegative provides a mock function with given fields: ctx, lang2, text
+func (_m *AzureTranslationRepository) AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error {
+	ret := _m.Called(ctx, lang2, text)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) error); ok {
+		r0 = rf(ctx, lang2, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Contain provides a mock function with given fields: ctx, lang2, text
 func (_m *AzureTranslationRepository) Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error) {
 	ret := _m.Called(ctx, lang2, text)
@@ -54,15 +68,15 @@ func (_m *AzureTranslationRepository) Contain(ctx context.Context, lang2 domain.
 }
 
 // Find provides a mock function with given fields: ctx, lang2, text
-func (_m *AzureTranslationRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.AzureTranslation, error) {
+func (_m *AzureTranslationRepository) Find(ctx context.Context, lang2 domain.Lang2, text string) ([]service.TranslationCandidate, error) {
 	ret := _m.Called(ctx, lang2, text)
 
-	var r0 []service.AzureTranslation
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) []service.AzureTranslation); ok {
+	var r0 []service.TranslationCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) []service.TranslationCandidate); ok {
 		r0 = rf(ctx, lang2, text)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]service.AzureTranslation)
+			r0 = ret.Get(0).([]service.TranslationCandidate)
 		}
 	}
 
@@ -99,6 +113,43 @@ func (_m *AzureTranslationRepository) FindByFirstLetter(ctx context.Context, lan
 	return r0, r1
 }
 
+// FindByLang2InBatches provides a mock function with given fields: ctx, lang2, batchSize, fn
+func (_m *AzureTranslationRepository) FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func([]string, []domain.Translation) error) error {
+	ret := _m.Called(ctx, lang2, batchSize, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, int, func([]string, []domain.Translation) error) error); ok {
+		r0 = rf(ctx, lang2, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByTarget provides a mock function with given fields: ctx, lang2, target, prefix
+func (_m *AzureTranslationRepository) FindByTarget(ctx context.Context, lang2 domain.Lang2, target string, prefix bool) ([]service.TranslationCacheEntry, error) {
+	ret := _m.Called(ctx, lang2, target, prefix)
+
+	var r0 []service.TranslationCacheEntry
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, bool) []service.TranslationCacheEntry); ok {
+		r0 = rf(ctx, lang2, target, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.TranslationCacheEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string, bool) error); ok {
+		r1 = rf(ctx, lang2, target, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByText provides a mock function with given fields: ctx, lang2, text
 func (_m *AzureTranslationRepository) FindByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, lang2, text)
@@ -145,6 +196,124 @@ func (_m *AzureTranslationRepository) FindByTextAndPos(ctx context.Context, lang
 	return r0, r1
 }
 
+// FindByTexts provides a mock function with given fields: ctx, lang2, texts
+func (_m *AzureTranslationRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]service.TranslationCandidate, error) {
+	ret := _m.Called(ctx, lang2, texts)
+
+	var r0 map[string][]service.TranslationCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, []string) map[string][]service.TranslationCandidate); ok {
+		r0 = rf(ctx, lang2, texts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]service.TranslationCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, []string) error); ok {
+		r1 = rf(ctx, lang2, texts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNegatives provides a mock function with given fields: ctx, lang2, condition
+func (_m *AzureTranslationRepository) FindNegatives(ctx context.Context, lang2 domain.Lang2, condition *service.TranslationSearchCondition) (*service.NegativeCacheSearchResult, error) {
+	ret := _m.Called(ctx, lang2, condition)
+
+	var r0 *service.NegativeCacheSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, *service.TranslationSearchCondition) *service.NegativeCacheSearchResult); ok {
+		r0 = rf(ctx, lang2, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.NegativeCacheSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, *service.TranslationSearchCondition) error); ok {
+		r1 = rf(ctx, lang2, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindStale provides a mock function with given fields: ctx, refreshedBefore, limit
+func (_m *AzureTranslationRepository) FindStale(ctx context.Context, refreshedBefore time.Time, limit int) ([]service.TranslationCacheEntry, error) {
+	ret := _m.Called(ctx, refreshedBefore, limit)
+
+	var r0 []service.TranslationCacheEntry
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []service.TranslationCacheEntry); ok {
+		r0 = rf(ctx, refreshedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.TranslationCacheEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, refreshedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refresh provides a mock function with given fields: ctx, lang2, text, result
+func (_m *AzureTranslationRepository) Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []service.TranslationCandidate) error {
+	ret := _m.Called(ctx, lang2, text, result)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, []service.TranslationCandidate) error); ok {
+		r0 = rf(ctx, lang2, text, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveNegatives provides a mock function with given fields: ctx, lang2, text
+func (_m *AzureTranslationRepository) RemoveNegatives(ctx context.Context, lang2 domain.Lang2, text string) (int64, error) {
+	ret := _m.Called(ctx, lang2, text)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) int64); ok {
+		r0 = rf(ctx, lang2, text)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string) error); ok {
+		r1 = rf(ctx, lang2, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Touch provides a mock function with given fields: ctx, lang2, text
+func (_m *AzureTranslationRepository) Touch(ctx context.Context, lang2 domain.Lang2, text string) error {
+	ret := _m.Called(ctx, lang2, text)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) error); ok {
+		r0 = rf(ctx, lang2, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewAzureTranslationRepository creates a new instance of AzureTranslationRepository. It also registers a cleanup function to assert the mocks expectations.
 func NewAzureTranslationRepository(t testing.TB) *AzureTranslationRepository {
 	mock := &AzureTranslationRepository{}
@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
-	mock "github.com/stretchr/testify/mock"
-
 	service "github.com/kujilabo/cocotola-translator-api/src/app/service"
+	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
 )
@@ -53,6 +52,29 @@ func (_m *CustomTranslationRepository) Contain(ctx context.Context, lang2 domain
 	return r0, r1
 }
 
+// FindByCondition provides a mock function with given fields: ctx, lang2, condition
+func (_m *CustomTranslationRepository) FindByCondition(ctx context.Context, lang2 domain.Lang2, condition *service.CustomTranslationSearchCondition) (*service.CustomTranslationSearchResult, error) {
+	ret := _m.Called(ctx, lang2, condition)
+
+	var r0 *service.CustomTranslationSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, *service.CustomTranslationSearchCondition) *service.CustomTranslationSearchResult); ok {
+		r0 = rf(ctx, lang2, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.CustomTranslationSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, *service.CustomTranslationSearchCondition) error); ok {
+		r1 = rf(ctx, lang2, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindByFirstLetter provides a mock function with given fields: ctx, lang2, firstLetter
 func (_m *CustomTranslationRepository) FindByFirstLetter(ctx context.Context, lang2 domain.Lang2, firstLetter string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, lang2, firstLetter)
@@ -76,6 +98,20 @@ func (_m *CustomTranslationRepository) FindByFirstLetter(ctx context.Context, la
 	return r0, r1
 }
 
+// FindByLang2InBatches provides a mock function with given fields: ctx, lang2, batchSize, fn
+func (_m *CustomTranslationRepository) FindByLang2InBatches(ctx context.Context, lang2 domain.Lang2, batchSize int, fn func([]domain.Translation) error) error {
+	ret := _m.Called(ctx, lang2, batchSize, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, int, func([]domain.Translation) error) error); ok {
+		r0 = rf(ctx, lang2, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByText provides a mock function with given fields: ctx, lang2, text
 func (_m *CustomTranslationRepository) FindByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, lang2, text)
@@ -122,13 +158,119 @@ func (_m *CustomTranslationRepository) FindByTextAndPos(ctx context.Context, lan
 	return r0, r1
 }
 
-// Remove provides a mock function with given fields: ctx, lang2, text, pos
-func (_m *CustomTranslationRepository) Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error {
+// FindByTexts provides a mock function with given fields: ctx, lang2, texts
+func (_m *CustomTranslationRepository) FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, lang2, texts)
+
+	var r0 []domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, []string) []domain.Translation); ok {
+		r0 = rf(ctx, lang2, texts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, []string) error); ok {
+		r1 = rf(ctx, lang2, texts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByTranslated provides a mock function with given fields: ctx, lang2, translated, prefix
+func (_m *CustomTranslationRepository) FindByTranslated(ctx context.Context, lang2 domain.Lang2, translated string, prefix bool) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, lang2, translated, prefix)
+
+	var r0 []domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, bool) []domain.Translation); ok {
+		r0 = rf(ctx, lang2, translated, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string, bool) error); ok {
+		r1 = rf(ctx, lang2, translated, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDisabled provides a mock function with given fields: ctx, lang2
+func (_m *CustomTranslationRepository) FindDisabled(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, lang2)
+
+	var r0 []domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2) []domain.Translation); ok {
+		r0 = rf(ctx, lang2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2) error); ok {
+		r1 = rf(ctx, lang2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindHistory provides a mock function with given fields: ctx, lang2, text, pos
+func (_m *CustomTranslationRepository) FindHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]service.CustomTranslationHistory, error) {
 	ret := _m.Called(ctx, lang2, text, pos)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos) error); ok {
+	var r0 []service.CustomTranslationHistory
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos) []service.CustomTranslationHistory); ok {
 		r0 = rf(ctx, lang2, text, pos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.CustomTranslationHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string, domain.WordPos) error); ok {
+		r1 = rf(ctx, lang2, text, pos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ctx, lang2, text, pos, version
+func (_m *CustomTranslationRepository) Remove(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	ret := _m.Called(ctx, lang2, text, pos, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos, int) error); ok {
+		r0 = rf(ctx, lang2, text, pos, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: ctx, lang2, text, pos, version
+func (_m *CustomTranslationRepository) Restore(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	ret := _m.Called(ctx, lang2, text, pos, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos, int) error); ok {
+		r0 = rf(ctx, lang2, text, pos, version)
 	} else {
 		r0 = ret.Error(0)
 	}
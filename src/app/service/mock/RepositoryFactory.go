@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
+
+	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
 )
 
 // RepositoryFactory is an autogenerated mock type for the RepositoryFactory type
@@ -16,6 +18,38 @@ type RepositoryFactory struct {
 	mock.Mock
 }
 
+// NewAzureExampleRepository provides a mock function with given fields: ctx
+func (_m *RepositoryFactory) NewAzureExampleRepository(ctx context.Context) service.AzureExampleRepository {
+	ret := _m.Called(ctx)
+
+	var r0 service.AzureExampleRepository
+	if rf, ok := ret.Get(0).(func(context.Context) service.AzureExampleRepository); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.AzureExampleRepository)
+		}
+	}
+
+	return r0
+}
+
+// NewAzureTextTranslationRepository provides a mock function with given fields: ctx
+func (_m *RepositoryFactory) NewAzureTextTranslationRepository(ctx context.Context) service.AzureTextTranslationRepository {
+	ret := _m.Called(ctx)
+
+	var r0 service.AzureTextTranslationRepository
+	if rf, ok := ret.Get(0).(func(context.Context) service.AzureTextTranslationRepository); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.AzureTextTranslationRepository)
+		}
+	}
+
+	return r0
+}
+
 // NewAzureTranslationRepository provides a mock function with given fields: ctx
 func (_m *RepositoryFactory) NewAzureTranslationRepository(ctx context.Context) service.AzureTranslationRepository {
 	ret := _m.Called(ctx)
@@ -48,6 +82,29 @@ func (_m *RepositoryFactory) NewCustomTranslationRepository(ctx context.Context)
 	return r0
 }
 
+// NewTranslationCacheRepository provides a mock function with given fields: ctx, providerName, fromLang
+func (_m *RepositoryFactory) NewTranslationCacheRepository(ctx context.Context, providerName string, fromLang domain.Lang2) (service.TranslationCacheRepository, error) {
+	ret := _m.Called(ctx, providerName, fromLang)
+
+	var r0 service.TranslationCacheRepository
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.Lang2) service.TranslationCacheRepository); ok {
+		r0 = rf(ctx, providerName, fromLang)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.TranslationCacheRepository)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.Lang2) error); ok {
+		r1 = rf(ctx, providerName, fromLang)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRepositoryFactory creates a new instance of RepositoryFactory. It also registers a cleanup function to assert the mocks expectations.
 func NewRepositoryFactory(t testing.TB) *RepositoryFactory {
 	mock := &RepositoryFactory{}
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
@@ -13,6 +14,36 @@ type TranslationUpdateParameter struct {
 	mock.Mock
 }
 
+// GetAnnotation provides a mock function with given fields:
+func (_m *TranslationUpdateParameter) GetAnnotation() domain.TranslationAnnotation {
+	ret := _m.Called()
+
+	var r0 domain.TranslationAnnotation
+	if rf, ok := ret.Get(0).(func() domain.TranslationAnnotation); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.TranslationAnnotation)
+	}
+
+	return r0
+}
+
+// GetSenses provides a mock function with given fields:
+func (_m *TranslationUpdateParameter) GetSenses() []domain.Sense {
+	ret := _m.Called()
+
+	var r0 []domain.Sense
+	if rf, ok := ret.Get(0).(func() []domain.Sense); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Sense)
+		}
+	}
+
+	return r0
+}
+
 // GetTranslated provides a mock function with given fields:
 func (_m *TranslationUpdateParameter) GetTranslated() string {
 	ret := _m.Called()
@@ -27,6 +58,20 @@ func (_m *TranslationUpdateParameter) GetTranslated() string {
 	return r0
 }
 
+// GetVersion provides a mock function with given fields:
+func (_m *TranslationUpdateParameter) GetVersion() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // NewTranslationUpdateParameter creates a new instance of TranslationUpdateParameter. It also registers a cleanup function to assert the mocks expectations.
 func NewTranslationUpdateParameter(t testing.TB) *TranslationUpdateParameter {
 	mock := &TranslationUpdateParameter{}
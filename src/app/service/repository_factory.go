@@ -3,10 +3,20 @@ package service
 
 import (
 	"context"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 )
 
 type RepositoryFactory interface {
+	// NewAzureTranslationRepository returns the repository of the english texts, which the admin API manages
 	NewAzureTranslationRepository(ctx context.Context) AzureTranslationRepository
 
 	NewCustomTranslationRepository(ctx context.Context) CustomTranslationRepository
+
+	NewAzureExampleRepository(ctx context.Context) AzureExampleRepository
+
+	NewAzureTextTranslationRepository(ctx context.Context) AzureTextTranslationRepository
+
+	// NewTranslationCacheRepository returns the repository which caches the results of the provider for the texts in fromLang
+	NewTranslationCacheRepository(ctx context.Context, providerName string, fromLang domain.Lang2) (TranslationCacheRepository, error)
 }
@@ -0,0 +1,12 @@
+//go:generate mockery --output mock --name TransactionManager
+package service
+
+import (
+	"context"
+)
+
+type TransactionManager interface {
+	// Do calls fn with the repository factory bound to a new transaction.
+	// The transaction is committed if fn returns nil, otherwise it is rolled back.
+	Do(ctx context.Context, fn func(rf RepositoryFactory) error) error
+}
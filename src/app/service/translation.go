@@ -6,3 +6,4 @@ import (
 
 var ErrTranslationNotFound = errors.New("translation not found")
 var ErrTranslationAlreadyExists = errors.New("custsomtranslation already exists")
+var ErrVersionConflict = errors.New("version conflict")
@@ -0,0 +1,156 @@
+//go:generate mockery --output mock --name TranslationProvider
+//go:generate mockery --output mock --name TranslationCacheRepository
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
+)
+
+const (
+	TranslationProviderCustom = "custom"
+	TranslationProviderAzure  = "azure"
+	TranslationProviderJMdict = "jmdict"
+)
+
+var ErrTranslationProviderNotFound = errors.New("translation provider not found")
+
+var ErrUnsupportedLanguagePair = errors.New("unsupported language pair")
+
+// TranslationCandidate is a translation which a TranslationProvider returns. It does not depend on the result type of any provider.
+// NormalizedSource and PrefixWord are empty if the provider does not have them.
+type TranslationCandidate struct {
+	Pos              domain.WordPos
+	Target           string
+	Confidence       float64
+	NormalizedSource string
+	PrefixWord       string
+	BackTranslations []domain.BackTranslation
+}
+
+// ToProviderTranslation converts the result of the provider into the translation
+func (t *TranslationCandidate) ToProviderTranslation(provider string, lang2 domain.Lang2, text string) (domain.Translation, error) {
+	return domain.NewTranslationWithDetail(1, time.Now(), time.Now(), text, t.Pos, lang2, t.Target, provider, domain.TranslationDetail{
+		Confidence:       t.Confidence,
+		NormalizedSource: t.NormalizedSource,
+		PrefixWord:       t.PrefixWord,
+		BackTranslations: t.BackTranslations,
+	})
+}
+
+// ToReverseTranslation converts the result of the provider looked up in the reverse direction into the translation of the target.
+// lang2 is the language of translated which was looked up.
+func (t *TranslationCandidate) ToReverseTranslation(provider string, lang2 domain.Lang2, translated string) (domain.Translation, error) {
+	return domain.NewTranslationWithDetail(1, time.Now(), time.Now(), t.Target, t.Pos, lang2, translated, provider, domain.TranslationDetail{
+		Confidence: t.Confidence,
+	})
+}
+
+// TranslationProvider is a dictionary service which translations are looked up from.
+type TranslationProvider interface {
+	DictionaryLookup(ctx context.Context, text string, fromLang, toLang domain.Lang2) ([]TranslationCandidate, error)
+
+	// DictionaryLookupBatch returns the translations keyed by text
+	DictionaryLookupBatch(ctx context.Context, texts []string, fromLang, toLang domain.Lang2) (map[string][]TranslationCandidate, error)
+
+	// SupportedLanguagePairs returns the pairs of the languages which texts can be looked up in.
+	// Translations can also be looked up in the reverse direction of the pairs to find the texts.
+	SupportedLanguagePairs() []domain.LanguagePair
+}
+
+// TranslationCacheEntry is a text and its translations stored in a TranslationCacheRepository.
+type TranslationCacheEntry struct {
+	Lang2       domain.Lang2
+	Text        string
+	Results     []TranslationCandidate
+	RefreshedAt time.Time
+}
+
+// TranslationCacheRepository stores the results of a TranslationProvider.
+// A text which the provider has no translations of is stored as a negative entry. It is contained and has no translations until it expires.
+type TranslationCacheRepository interface {
+	Add(ctx context.Context, lang2 domain.Lang2, text string, result []TranslationCandidate) error
+
+	// AddNegative records that the provider has no translations of the text
+	AddNegative(ctx context.Context, lang2 domain.Lang2, text string) error
+
+	Find(ctx context.Context, lang2 domain.Lang2, text string) ([]TranslationCandidate, error)
+
+	// FindByTexts returns the cached translations keyed by text. Texts which are not cached are not contained.
+	FindByTexts(ctx context.Context, lang2 domain.Lang2, texts []string) (map[string][]TranslationCandidate, error)
+
+	Contain(ctx context.Context, lang2 domain.Lang2, text string) (bool, error)
+
+	// FindByTarget returns the entries which have the translations equal to target, or starting with target if prefix is true.
+	// The results of each entry contain only the matched translations. Negative entries are not returned.
+	FindByTarget(ctx context.Context, lang2 domain.Lang2, target string, prefix bool) ([]TranslationCacheEntry, error)
+
+	// FindStale returns at most limit entries which have not been refreshed since refreshedBefore, the oldest first. Negative entries are not returned.
+	FindStale(ctx context.Context, refreshedBefore time.Time, limit int) ([]TranslationCacheEntry, error)
+
+	// Refresh replaces the translations of the text and updates its refreshed time
+	Refresh(ctx context.Context, lang2 domain.Lang2, text string, result []TranslationCandidate) error
+
+	// Touch updates the refreshed time of the text without changing its translations
+	Touch(ctx context.Context, lang2 domain.Lang2, text string) error
+}
+
+type TranslationProviderChainItem struct {
+	Name     string
+	Provider TranslationProvider
+	Cached   bool
+}
+
+type TranslationProviderChainParameter struct {
+	Name   string
+	Cached bool
+}
+
+type TranslationProviderRegistry interface {
+	Register(name string, provider TranslationProvider) error
+
+	// NewChain returns the providers in the order of params
+	NewChain(params []TranslationProviderChainParameter) ([]TranslationProviderChainItem, error)
+}
+
+type translationProviderRegistry struct {
+	providers map[string]TranslationProvider
+}
+
+func NewTranslationProviderRegistry() TranslationProviderRegistry {
+	return &translationProviderRegistry{
+		providers: make(map[string]TranslationProvider),
+	}
+}
+
+func (r *translationProviderRegistry) Register(name string, provider TranslationProvider) error {
+	if name == TranslationProviderCustom {
+		return liberrors.Errorf("reserved translation provider name. name: %s", name)
+	}
+	if _, ok := r.providers[name]; ok {
+		return liberrors.Errorf("translation provider is already registered. name: %s", name)
+	}
+
+	r.providers[name] = provider
+	return nil
+}
+
+func (r *translationProviderRegistry) NewChain(params []TranslationProviderChainParameter) ([]TranslationProviderChainItem, error) {
+	chain := make([]TranslationProviderChainItem, len(params))
+	for i, p := range params {
+		provider, ok := r.providers[p.Name]
+		if !ok {
+			return nil, liberrors.Errorf("failed to NewChain. name: %s, err: %w", p.Name, ErrTranslationProviderNotFound)
+		}
+		chain[i] = TranslationProviderChainItem{
+			Name:     p.Name,
+			Provider: provider,
+			Cached:   p.Cached,
+		}
+	}
+	return chain, nil
+}
@@ -4,6 +4,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 
@@ -24,7 +25,37 @@ type AdminUsecase interface {
 
 	UpdateTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, param service.TranslationUpdateParameter) error
 
-	RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error
+	// RemoveTranslation disables the custom translation, which also suppresses the translations of the providers for the text and pos.
+	// version is the version which the translation is expected to have, and 0 means any version.
+	RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error
+
+	// RestoreTranslation restores the removed custom translation. The translations of the providers are shown again if the custom translation has no translated text.
+	// version is the version which the translation is expected to have, and 0 means any version.
+	RestoreTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error
+
+	// FindDisabledTranslations returns the removed custom translations which suppress the translations of the providers
+	FindDisabledTranslations(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error)
+
+	FindTranslationHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]service.CustomTranslationHistory, error)
+
+	// RevertTranslation changes the custom translation back to toVersion in the history. The translation is added again if it has been removed.
+	// version is the version which the translation is expected to have, and 0 means any version.
+	RevertTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, toVersion, version int) error
+
+	ExportTranslations(ctx context.Context, lang2 domain.Lang2, presenter AdminPresenter) error
+
+	// ImportTranslations upserts rows into custom translations in a single transaction.
+	// Nothing is written if dryRun is true or any row is invalid.
+	ImportTranslations(ctx context.Context, rows []TranslationImportRow, dryRun bool) (*TranslationImportResults, error)
+
+	// SearchTranslations returns the page of the custom translations which have the tag and the level. Removed ones are not included.
+	SearchTranslations(ctx context.Context, lang2 domain.Lang2, condition *service.CustomTranslationSearchCondition) (*service.CustomTranslationSearchResult, error)
+
+	// FindNegativeCaches returns the texts which azure has no translations of
+	FindNegativeCaches(ctx context.Context, lang2 domain.Lang2, condition *service.TranslationSearchCondition) (*service.NegativeCacheSearchResult, error)
+
+	// RemoveNegativeCaches removes the negative caches of the text and returns the number of the removed ones. All the negative caches of lang2 are removed if text is empty.
+	RemoveNegativeCaches(ctx context.Context, lang2 domain.Lang2, text string) (int64, error)
 }
 
 type AdminPresenter interface {
@@ -32,12 +63,18 @@ type AdminPresenter interface {
 	WriteTranslation(ctx context.Context, translation domain.Translation) error
 }
 
+const exportBatchSize = 100
+
 type adminUsecase struct {
-	rf service.RepositoryFactory
+	rf                 service.RepositoryFactory
+	transactionManager service.TransactionManager
 }
 
-func NewAdminUsecase(rf service.RepositoryFactory) AdminUsecase {
-	return &adminUsecase{rf: rf}
+func NewAdminUsecase(rf service.RepositoryFactory, transactionManager service.TransactionManager) AdminUsecase {
+	return &adminUsecase{
+		rf:                 rf,
+		transactionManager: transactionManager,
+	}
 }
 
 func (u *adminUsecase) FindTranslationsByFirstLetter(ctx context.Context, lang2 domain.Lang2, firstLetter string) ([]domain.Translation, error) {
@@ -67,8 +104,12 @@ func (u *adminUsecase) FindTranslationsByFirstLetter(ctx context.Context, lang2
 		}
 	}
 
+	// disabled custom translations suppress the azure translations
 	results := make([]domain.Translation, 0)
 	for _, v := range resultMap {
+		if v.IsDisabled() {
+			continue
+		}
 		results = append(results, v)
 	}
 
@@ -81,6 +122,9 @@ func (u *adminUsecase) FindTranslationByTextAndPos(ctx context.Context, lang2 do
 	customRepo := u.rf.NewCustomTranslationRepository(ctx)
 	customResult, err := customRepo.FindByTextAndPos(ctx, lang2, text, pos)
 	if err == nil {
+		if customResult.IsDisabled() {
+			return nil, service.ErrTranslationNotFound
+		}
 		return customResult, nil
 	}
 	if !errors.Is(err, service.ErrTranslationNotFound) {
@@ -124,9 +168,12 @@ func (u *adminUsecase) FindTranslationByText(ctx context.Context, lang2 domain.L
 		}
 	}
 
-	// convert map to list
+	// convert map to list. disabled custom translations suppress the azure translations
 	results := make([]domain.Translation, 0)
 	for _, v := range resultMap {
+		if v.IsDisabled() {
+			continue
+		}
 		results = append(results, v)
 	}
 
@@ -162,7 +209,12 @@ func (u *adminUsecase) UpdateTranslation(ctx context.Context, lang2 domain.Lang2
 		return nil
 	}
 
-	paramToAdd, err := service.NewTransalationAddParameter(text, pos, lang2, param.GetTranslated())
+	// the translation expected to be updated has been removed by someone else
+	if param.GetVersion() != 0 {
+		return service.ErrVersionConflict
+	}
+
+	paramToAdd, err := service.NewTransalationAddParameterWithAnnotation(text, pos, lang2, param.GetSenses(), param.GetAnnotationUpdate().Apply(domain.TranslationAnnotation{}))
 	if err != nil {
 		return err
 	}
@@ -172,10 +224,330 @@ func (u *adminUsecase) UpdateTranslation(ctx context.Context, lang2 domain.Lang2
 	return nil
 }
 
-func (u *adminUsecase) RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error {
+func (u *adminUsecase) RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
 	customRepo := u.rf.NewCustomTranslationRepository(ctx)
-	if err := customRepo.Remove(ctx, lang2, text, pos); err != nil {
+	if err := customRepo.Remove(ctx, lang2, text, pos, version); err != nil {
 		return liberrors.Errorf("failed to customRepo.Remove in adminUsecase.RemoveTranslation. err: %w", err)
 	}
 	return nil
 }
+
+func (u *adminUsecase) RestoreTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	if err := customRepo.Restore(ctx, lang2, text, pos, version); err != nil {
+		return liberrors.Errorf("failed to customRepo.Restore in adminUsecase.RestoreTranslation. err: %w", err)
+	}
+	return nil
+}
+
+func (u *adminUsecase) FindDisabledTranslations(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error) {
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	results, err := customRepo.FindDisabled(ctx, lang2)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to customRepo.FindDisabled in adminUsecase.FindDisabledTranslations. err: %w", err)
+	}
+	return results, nil
+}
+
+func (u *adminUsecase) FindTranslationHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]service.CustomTranslationHistory, error) {
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	results, err := customRepo.FindHistory(ctx, lang2, text, pos)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to customRepo.FindHistory in adminUsecase.FindTranslationHistory. err: %w", err)
+	}
+	return results, nil
+}
+
+func (u *adminUsecase) RevertTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, toVersion, version int) error {
+	if err := u.transactionManager.Do(ctx, func(rf service.RepositoryFactory) error {
+		customRepo := rf.NewCustomTranslationRepository(ctx)
+
+		histories, err := customRepo.FindHistory(ctx, lang2, text, pos)
+		if err != nil {
+			return err
+		}
+		senses, ok := findSensesOfVersion(histories, toVersion)
+		if !ok {
+			return liberrors.Errorf("version is not found in the history. version: %d, err: %w", toVersion, service.ErrTranslationNotFound)
+		}
+
+		current, err := customRepo.FindByTextAndPos(ctx, lang2, text, pos)
+		if errors.Is(err, service.ErrTranslationNotFound) {
+			if version != 0 {
+				// the translation expected to be reverted has been removed by someone else
+				return service.ErrVersionConflict
+			}
+			paramToAdd, err := service.NewTransalationAddParameterWithSenses(text, pos, lang2, senses)
+			if err != nil {
+				return err
+			}
+			return customRepo.Add(ctx, paramToAdd)
+		} else if err != nil {
+			return err
+		}
+
+		if version == 0 {
+			version = current.GetVersion()
+		}
+		// the annotation is not recorded in the history so that the current one is kept
+		param, err := service.NewTransaltionUpdateParameterWithAnnotation(senses, current.GetAnnotation(), version)
+		if err != nil {
+			return err
+		}
+		return customRepo.Update(ctx, lang2, text, pos, param)
+	}); err != nil {
+		return liberrors.Errorf("failed to transactionManager.Do in adminUsecase.RevertTranslation. err: %w", err)
+	}
+	return nil
+}
+
+// findSensesOfVersion returns the senses which the translation had at the version
+func findSensesOfVersion(histories []service.CustomTranslationHistory, version int) ([]domain.Sense, bool) {
+	for _, h := range histories {
+		if h.Version == version && h.Action != service.CustomTranslationActionRemove && len(h.NewSenses) > 0 {
+			return h.NewSenses, true
+		}
+	}
+	return nil, false
+}
+
+func (u *adminUsecase) SearchTranslations(ctx context.Context, lang2 domain.Lang2, condition *service.CustomTranslationSearchCondition) (*service.CustomTranslationSearchResult, error) {
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	result, err := customRepo.FindByCondition(ctx, lang2, condition)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to customRepo.FindByCondition in adminUsecase.SearchTranslations. err: %w", err)
+	}
+	return result, nil
+}
+
+func (u *adminUsecase) FindNegativeCaches(ctx context.Context, lang2 domain.Lang2, condition *service.TranslationSearchCondition) (*service.NegativeCacheSearchResult, error) {
+	azureRepo := u.rf.NewAzureTranslationRepository(ctx)
+	results, err := azureRepo.FindNegatives(ctx, lang2, condition)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to azureRepo.FindNegatives in adminUsecase.FindNegativeCaches. err: %w", err)
+	}
+	return results, nil
+}
+
+func (u *adminUsecase) RemoveNegativeCaches(ctx context.Context, lang2 domain.Lang2, text string) (int64, error) {
+	azureRepo := u.rf.NewAzureTranslationRepository(ctx)
+	removed, err := azureRepo.RemoveNegatives(ctx, lang2, text)
+	if err != nil {
+		return 0, liberrors.Errorf("failed to azureRepo.RemoveNegatives in adminUsecase.RemoveNegativeCaches. err: %w", err)
+	}
+	return removed, nil
+}
+
+func (u *adminUsecase) ExportTranslations(ctx context.Context, lang2 domain.Lang2, presenter AdminPresenter) error {
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	azureRepo := u.rf.NewAzureTranslationRepository(ctx)
+
+	// texts registered in azure_translation. custom translations take priority over azure translations
+	if err := azureRepo.FindByLang2InBatches(ctx, lang2, exportBatchSize, func(texts []string, azureResults []domain.Translation) error {
+		customResults, err := customRepo.FindByTexts(ctx, lang2, texts)
+		if err != nil {
+			return err
+		}
+
+		return presenter.WriteTranslations(ctx, u.mergeTranslations(texts, customResults, azureResults))
+	}); err != nil {
+		return liberrors.Errorf("failed to azureRepo.FindByLang2InBatches in adminUsecase.ExportTranslations. err: %w", err)
+	}
+
+	// texts registered only in custom_translation
+	if err := customRepo.FindByLang2InBatches(ctx, lang2, exportBatchSize, func(customResults []domain.Translation) error {
+		texts := make([]string, 0)
+		for _, c := range customResults {
+			if len(texts) == 0 || texts[len(texts)-1] != c.GetText() {
+				texts = append(texts, c.GetText())
+			}
+		}
+
+		azureResults, err := azureRepo.FindByTexts(ctx, lang2, texts)
+		if err != nil {
+			return err
+		}
+
+		results := make([]domain.Translation, 0)
+		for _, c := range customResults {
+			if _, ok := azureResults[c.GetText()]; !ok && !c.IsDisabled() {
+				results = append(results, c)
+			}
+		}
+		if len(results) == 0 {
+			return nil
+		}
+
+		return presenter.WriteTranslations(ctx, results)
+	}); err != nil {
+		return liberrors.Errorf("failed to customRepo.FindByLang2InBatches in adminUsecase.ExportTranslations. err: %w", err)
+	}
+
+	return nil
+}
+
+// mergeTranslations merges custom and azure translations per (text, pos) in the same way as FindTranslationByText.
+// The results keep the order of texts and are sorted by pos in each text.
+func (u *adminUsecase) mergeTranslations(texts []string, customResults, azureResults []domain.Translation) []domain.Translation {
+	resultMap := make(map[string]map[domain.WordPos]domain.Translation)
+	for _, text := range texts {
+		resultMap[text] = make(map[domain.WordPos]domain.Translation)
+	}
+	for _, c := range customResults {
+		if m, ok := resultMap[c.GetText()]; ok {
+			m[c.GetPos()] = c
+		}
+	}
+	for _, a := range azureResults {
+		if m, ok := resultMap[a.GetText()]; ok {
+			if _, ok := m[a.GetPos()]; !ok {
+				m[a.GetPos()] = a
+			}
+		}
+	}
+
+	results := make([]domain.Translation, 0)
+	for _, text := range texts {
+		translations := make([]domain.Translation, 0)
+		for _, v := range resultMap[text] {
+			if v.IsDisabled() {
+				continue
+			}
+			translations = append(translations, v)
+		}
+		sort.Slice(translations, func(i, j int) bool { return translations[i].GetPos() < translations[j].GetPos() })
+		results = append(results, translations...)
+	}
+	return results
+}
+
+func (u *adminUsecase) ImportTranslations(ctx context.Context, rows []TranslationImportRow, dryRun bool) (*TranslationImportResults, error) {
+	makeKey := func(lang2 string, text string, pos domain.WordPos) string {
+		return lang2 + "_" + text + "_" + strconv.Itoa(int(pos))
+	}
+
+	results := &TranslationImportResults{
+		DryRun:  dryRun,
+		Results: make([]TranslationImportResult, len(rows)),
+	}
+
+	// validate rows
+	params := make([]service.TranslationAddParameter, len(rows))
+	rowNoMap := make(map[string]int)
+	for i, row := range rows {
+		rowNo := i + 1
+		results.Results[i] = TranslationImportResult{
+			RowNo: rowNo,
+			Lang2: row.Lang2,
+			Text:  row.Text,
+			Pos:   row.Pos,
+		}
+
+		param, err := u.toTranslationAddParameter(row)
+		if err != nil {
+			results.Results[i].Status = TranslationImportStatusInvalid
+			results.Results[i].Message = err.Error()
+			continue
+		}
+
+		key := makeKey(row.Lang2, row.Text, param.GetPos())
+		if duplicatedRowNo, ok := rowNoMap[key]; ok {
+			results.Results[i].Status = TranslationImportStatusInvalid
+			results.Results[i].Message = fmt.Sprintf("duplicated with row %d", duplicatedRowNo)
+			continue
+		}
+		rowNoMap[key] = rowNo
+		params[i] = param
+	}
+
+	if !dryRun && results.HasInvalidRows() {
+		return results, nil
+	}
+
+	if err := u.transactionManager.Do(ctx, func(rf service.RepositoryFactory) error {
+		customRepo := rf.NewCustomTranslationRepository(ctx)
+
+		// find existing translations with one query per lang2
+		lang2Map := make(map[string]domain.Lang2)
+		textsMap := make(map[string][]string)
+		for _, param := range params {
+			if param == nil {
+				continue
+			}
+			lang2 := param.GetLang2().String()
+			lang2Map[lang2] = param.GetLang2()
+			textsMap[lang2] = append(textsMap[lang2], param.GetText())
+		}
+		existingMap := make(map[string]domain.Translation)
+		for lang2, texts := range textsMap {
+			existings, err := customRepo.FindByTexts(ctx, lang2Map[lang2], texts)
+			if err != nil {
+				return err
+			}
+			for _, e := range existings {
+				existingMap[makeKey(lang2, e.GetText(), e.GetPos())] = e
+			}
+		}
+
+		for i, param := range params {
+			if param == nil {
+				continue
+			}
+
+			existing, ok := existingMap[makeKey(param.GetLang2().String(), param.GetText(), param.GetPos())]
+			if !ok {
+				results.Results[i].Status = TranslationImportStatusCreated
+				if dryRun {
+					continue
+				}
+				if err := customRepo.Add(ctx, param); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// the disabled translation is enabled by the update
+			if existing.GetTranslated() == param.GetTranslated() && !existing.IsDisabled() {
+				results.Results[i].Status = TranslationImportStatusUnchanged
+				continue
+			}
+
+			results.Results[i].Status = TranslationImportStatusUpdated
+			if dryRun {
+				continue
+			}
+			// rows have no annotation so that the current one is kept
+			updateParam, err := service.NewTransaltionUpdateParameterWithAnnotation(param.GetSenses(), existing.GetAnnotation(), existing.GetVersion())
+			if err != nil {
+				return err
+			}
+			if err := customRepo.Update(ctx, param.GetLang2(), param.GetText(), param.GetPos(), updateParam); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, liberrors.Errorf("failed to transactionManager.Do in adminUsecase.ImportTranslations. err: %w", err)
+	}
+
+	results.Committed = !dryRun
+	return results, nil
+}
+
+func (u *adminUsecase) toTranslationAddParameter(row TranslationImportRow) (service.TranslationAddParameter, error) {
+	lang2, err := domain.NewLang2(row.Lang2)
+	if err != nil {
+		return nil, errors.New("invalid lang2")
+	}
+
+	pos, err := domain.NewWordPos(row.Pos)
+	if err != nil {
+		return nil, errors.New("invalid pos")
+	}
+
+	param, err := service.NewTransalationAddParameter(row.Text, pos, lang2, row.Translated)
+	if err != nil {
+		return nil, errors.New("text and translated are required")
+	}
+	return param, nil
+}
@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	service_mock "github.com/kujilabo/cocotola-translator-api/src/app/service/mock"
 	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func matchErrorFunc(expected error) assert.ErrorAssertionFunc {
@@ -28,32 +31,368 @@ func Test_adminUsecase_RemoveTranslation(t *testing.T) {
 
 	// given
 	customRepo := new(service_mock.CustomTranslationRepository)
-	customRepo.On("Remove", anythingOfContext, domain.Lang2JA, "apple", domain.PosNoun).Return(nil)
-	customRepo.On("Remove", anythingOfContext, domain.Lang2JA, "orange", domain.PosNoun).Return(service.ErrTranslationNotFound)
+	customRepo.On("Remove", anythingOfContext, domain.Lang2JA, "apple", domain.PosNoun, 0).Return(nil)
+	customRepo.On("Remove", anythingOfContext, domain.Lang2JA, "orange", domain.PosNoun, 0).Return(service.ErrTranslationNotFound)
+	customRepo.On("Remove", anythingOfContext, domain.Lang2JA, "apple", domain.PosNoun, 1).Return(service.ErrVersionConflict)
 	rf := new(service_mock.RepositoryFactory)
 	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
-	adminUsecase := usecase.NewAdminUsecase(rf)
+	adminUsecase := usecase.NewAdminUsecase(rf, new(service_mock.TransactionManager))
 
 	type args struct {
-		lang2 domain.Lang2
-		text  string
-		pos   domain.WordPos
+		lang2   domain.Lang2
+		text    string
+		pos     domain.WordPos
+		version int
 	}
 	tests := []struct {
 		name      string
 		args      args
 		assertion assert.ErrorAssertionFunc
 	}{
-		{"word is registered", args{domain.Lang2JA, "apple", domain.PosNoun}, assert.NoError},
-		{"word is not registered", args{domain.Lang2JA, "orange", domain.PosNoun}, matchErrorFunc(service.ErrTranslationNotFound)},
+		{"word is registered", args{domain.Lang2JA, "apple", domain.PosNoun, 0}, assert.NoError},
+		{"word is not registered", args{domain.Lang2JA, "orange", domain.PosNoun, 0}, matchErrorFunc(service.ErrTranslationNotFound)},
+		{"word has been modified", args{domain.Lang2JA, "apple", domain.PosNoun, 1}, matchErrorFunc(service.ErrVersionConflict)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// when
-			err := adminUsecase.RemoveTranslation(bg, tt.args.lang2, tt.args.text, tt.args.pos)
+			err := adminUsecase.RemoveTranslation(bg, tt.args.lang2, tt.args.text, tt.args.pos, tt.args.version)
 
 			// then
 			tt.assertion(t, err)
 		})
 	}
 }
+
+func Test_adminUsecase_UpdateTranslation(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - "apple" is registered and "orange" is not
+	apple, err := domain.NewTranslation(2, time.Now(), time.Now(), "apple", domain.PosNoun, domain.Lang2JA, "りんご", service.TranslationProviderCustom)
+	require.NoError(t, err)
+	customRepo := new(service_mock.CustomTranslationRepository)
+	customRepo.On("FindByTextAndPos", anythingOfContext, domain.Lang2JA, "apple", domain.PosNoun).Return(apple, nil)
+	customRepo.On("FindByTextAndPos", anythingOfContext, domain.Lang2JA, "orange", domain.PosNoun).Return(nil, service.ErrTranslationNotFound)
+	customRepo.On("Update", anythingOfContext, domain.Lang2JA, "apple", domain.PosNoun, mock.Anything).Return(nil)
+	customRepo.On("Add", anythingOfContext, mock.Anything).Return(nil)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
+	adminUsecase := usecase.NewAdminUsecase(rf, new(service_mock.TransactionManager))
+
+	tests := []struct {
+		name      string
+		text      string
+		version   int
+		assertion assert.ErrorAssertionFunc
+		wantAdded bool
+	}{
+		{"word is registered", "apple", 2, assert.NoError, false},
+		{"word is not registered", "orange", 0, assert.NoError, true},
+		{"word has been removed", "orange", 1, matchErrorFunc(service.ErrVersionConflict), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customRepo.Calls = nil
+			param, err := service.NewTransaltionUpdateParameter("訳", tt.version)
+			require.NoError(t, err)
+
+			// when
+			err = adminUsecase.UpdateTranslation(bg, domain.Lang2JA, tt.text, domain.PosNoun, param)
+
+			// then
+			tt.assertion(t, err)
+			if tt.wantAdded {
+				customRepo.AssertNumberOfCalls(t, "Add", 1)
+			} else {
+				customRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_adminUsecase_FindTranslationByText_disabled(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - the verb of "can" is disabled and the noun is removed with its translated text
+	now := time.Now()
+	canVerb, err := domain.NewDisabledTranslation(2, now, now, "can", domain.PosVerb, domain.Lang2JA, "", service.TranslationProviderCustom, domain.TranslationDetail{})
+	require.NoError(t, err)
+	canNoun, err := domain.NewDisabledTranslation(3, now, now, "can", domain.PosNoun, domain.Lang2JA, "缶c", service.TranslationProviderCustom, domain.TranslationDetail{})
+	require.NoError(t, err)
+	canVerbA, err := domain.NewTranslation(1, now, now, "can", domain.PosVerb, domain.Lang2JA, "できる", service.TranslationProviderAzure)
+	require.NoError(t, err)
+	canAdjA, err := domain.NewTranslation(1, now, now, "can", domain.PosAdj, domain.Lang2JA, "可能な", service.TranslationProviderAzure)
+	require.NoError(t, err)
+	customRepo := new(service_mock.CustomTranslationRepository)
+	customRepo.On("FindByText", anythingOfContext, domain.Lang2JA, "can").Return([]domain.Translation{canVerb, canNoun}, nil)
+	customRepo.On("FindByTextAndPos", anythingOfContext, domain.Lang2JA, "can", domain.PosVerb).Return(canVerb, nil)
+	azureRepo := new(service_mock.AzureTranslationRepository)
+	azureRepo.On("FindByText", anythingOfContext, domain.Lang2JA, "can").Return([]domain.Translation{canVerbA, canAdjA}, nil)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
+	rf.On("NewAzureTranslationRepository", anythingOfContext).Return(azureRepo)
+	adminUsecase := usecase.NewAdminUsecase(rf, new(service_mock.TransactionManager))
+
+	// when
+	actual, err := adminUsecase.FindTranslationByText(bg, domain.Lang2JA, "can")
+	require.NoError(t, err)
+
+	// then
+	// - the disabled translations and the azure verb are not returned
+	require.Equal(t, 1, len(actual))
+	assert.Equal(t, "可能な", actual[0].GetTranslated())
+
+	// when
+	_, err = adminUsecase.FindTranslationByTextAndPos(bg, domain.Lang2JA, "can", domain.PosVerb)
+
+	// then
+	assert.ErrorIs(t, err, service.ErrTranslationNotFound)
+	azureRepo.AssertNotCalled(t, "FindByTextAndPos", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+type translationCollector struct {
+	translations []domain.Translation
+}
+
+func (p *translationCollector) WriteTranslations(ctx context.Context, translations []domain.Translation) error {
+	p.translations = append(p.translations, translations...)
+	return nil
+}
+
+func (p *translationCollector) WriteTranslation(ctx context.Context, translation domain.Translation) error {
+	p.translations = append(p.translations, translation)
+	return nil
+}
+
+func Test_adminUsecase_ExportTranslations(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	now := time.Now()
+	newTranslation := func(text string, pos domain.WordPos, translated, provider string) domain.Translation {
+		translation, err := domain.NewTranslation(1, now, now, text, pos, domain.Lang2JA, translated, provider)
+		require.NoError(t, err)
+		return translation
+	}
+	bookNounC := newTranslation("book", domain.PosNoun, "本c", "custom")
+	bookNounA := newTranslation("book", domain.PosNoun, "本a", "azure")
+	bookVerbA := newTranslation("book", domain.PosVerb, "予約するa", "azure")
+	catNounC := newTranslation("cat", domain.PosNoun, "猫c", "custom")
+	customRepo := new(service_mock.CustomTranslationRepository)
+	azureRepo := new(service_mock.AzureTranslationRepository)
+	// - azureRepo has "book"
+	azureRepo.On("FindByLang2InBatches", anythingOfContext, domain.Lang2JA, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		fn := args.Get(3).(func([]string, []domain.Translation) error)
+		require.NoError(t, fn([]string{"book"}, []domain.Translation{bookVerbA, bookNounA}))
+	})
+	azureRepo.On("FindByTexts", anythingOfContext, domain.Lang2JA, []string{"book", "cat"}).Return(map[string][]service.TranslationCandidate{
+		"book": {{Pos: domain.PosNoun, Target: "本a", Confidence: 1}},
+	}, nil)
+	// - customRepo has "book" and "cat"
+	customRepo.On("FindByTexts", anythingOfContext, domain.Lang2JA, []string{"book"}).Return([]domain.Translation{bookNounC}, nil)
+	customRepo.On("FindByLang2InBatches", anythingOfContext, domain.Lang2JA, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		fn := args.Get(3).(func([]domain.Translation) error)
+		require.NoError(t, fn([]domain.Translation{bookNounC, catNounC}))
+	})
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
+	rf.On("NewAzureTranslationRepository", anythingOfContext).Return(azureRepo)
+	adminUsecase := usecase.NewAdminUsecase(rf, new(service_mock.TransactionManager))
+	presenter := &translationCollector{}
+
+	// when
+	err := adminUsecase.ExportTranslations(bg, domain.Lang2JA, presenter)
+	require.NoError(t, err)
+
+	// then
+	// - the custom translation of "book" overrides the azure one and "cat" is exported once
+	require.Equal(t, 3, len(presenter.translations))
+	assert.Equal(t, "本c", presenter.translations[0].GetTranslated())
+	assert.Equal(t, "予約するa", presenter.translations[1].GetTranslated())
+	assert.Equal(t, "猫c", presenter.translations[2].GetTranslated())
+}
+
+func test_adminUsecase_ImportTranslations_init(t *testing.T) (*service_mock.CustomTranslationRepository, usecase.AdminUsecase) {
+	customRepo := new(service_mock.CustomTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
+	transactionManager := new(service_mock.TransactionManager)
+	transactionManager.On("Do", anythingOfContext, mock.Anything).Return(func(ctx context.Context, fn func(service.RepositoryFactory) error) error {
+		return fn(rf)
+	})
+
+	// - customRepo has "book"(noun) and "cat"(noun)
+	now := time.Now()
+	bookNoun, err := domain.NewTranslation(1, now, now, "book", domain.PosNoun, domain.Lang2JA, "本", "custom")
+	require.NoError(t, err)
+	catNoun, err := domain.NewTranslation(1, now, now, "cat", domain.PosNoun, domain.Lang2JA, "猫", "custom")
+	require.NoError(t, err)
+	customRepo.On("FindByTexts", anythingOfContext, domain.Lang2JA, mock.Anything).Return([]domain.Translation{bookNoun, catNoun}, nil)
+
+	return customRepo, usecase.NewAdminUsecase(rf, transactionManager)
+}
+
+func Test_adminUsecase_ImportTranslations_dryRun(t *testing.T) {
+	bg := context.Background()
+	customRepo, adminUsecase := test_adminUsecase_ImportTranslations_init(t)
+
+	// given
+	rows := []usecase.TranslationImportRow{
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosNoun), Translated: "本"},
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosVerb), Translated: "予約する"},
+		{Lang2: "ja", Text: "cat", Pos: int(domain.PosNoun), Translated: "ネコ"},
+		{Lang2: "ja", Text: "dog", Pos: 50, Translated: "犬"},
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosVerb), Translated: "予約"},
+	}
+
+	// when
+	actual, err := adminUsecase.ImportTranslations(bg, rows, true)
+	require.NoError(t, err)
+
+	// then
+	assert.True(t, actual.DryRun)
+	assert.False(t, actual.Committed)
+	require.Equal(t, 5, len(actual.Results))
+	assert.Equal(t, usecase.TranslationImportStatusUnchanged, actual.Results[0].Status)
+	assert.Equal(t, usecase.TranslationImportStatusCreated, actual.Results[1].Status)
+	assert.Equal(t, usecase.TranslationImportStatusUpdated, actual.Results[2].Status)
+	assert.Equal(t, usecase.TranslationImportStatusInvalid, actual.Results[3].Status)
+	assert.Equal(t, usecase.TranslationImportStatusInvalid, actual.Results[4].Status)
+	// - nothing is written
+	customRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	customRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_adminUsecase_ImportTranslations_invalidRows(t *testing.T) {
+	bg := context.Background()
+	customRepo, adminUsecase := test_adminUsecase_ImportTranslations_init(t)
+
+	// given
+	rows := []usecase.TranslationImportRow{
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosVerb), Translated: "予約する"},
+		{Lang2: "japanese", Text: "dog", Pos: int(domain.PosNoun), Translated: "犬"},
+		{Lang2: "ja", Text: "dog", Pos: int(domain.PosNoun), Translated: ""},
+	}
+
+	// when
+	actual, err := adminUsecase.ImportTranslations(bg, rows, false)
+	require.NoError(t, err)
+
+	// then
+	assert.False(t, actual.Committed)
+	assert.Equal(t, usecase.TranslationImportStatusInvalid, actual.Results[1].Status)
+	// - an empty translated is invalid
+	assert.Equal(t, usecase.TranslationImportStatusInvalid, actual.Results[2].Status)
+	customRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+}
+
+func Test_adminUsecase_ImportTranslations_commit(t *testing.T) {
+	bg := context.Background()
+	customRepo, adminUsecase := test_adminUsecase_ImportTranslations_init(t)
+
+	// given
+	rows := []usecase.TranslationImportRow{
+		{Lang2: "ja", Text: "book", Pos: int(domain.PosVerb), Translated: "予約する"},
+		{Lang2: "ja", Text: "cat", Pos: int(domain.PosNoun), Translated: "ネコ"},
+	}
+	customRepo.On("Add", anythingOfContext, mock.Anything).Return(nil)
+	customRepo.On("Update", anythingOfContext, domain.Lang2JA, "cat", domain.PosNoun, mock.Anything).Return(nil)
+
+	// when
+	actual, err := adminUsecase.ImportTranslations(bg, rows, false)
+	require.NoError(t, err)
+
+	// then
+	assert.True(t, actual.Committed)
+	assert.Equal(t, usecase.TranslationImportStatusCreated, actual.Results[0].Status)
+	assert.Equal(t, usecase.TranslationImportStatusUpdated, actual.Results[1].Status)
+	customRepo.AssertNumberOfCalls(t, "Add", 1)
+	customRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func Test_adminUsecase_RevertTranslation(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - "book" was added as "本" and "書物" and updated to "書籍", and it is tagged with education
+	// - "cat" was added as "猫" and removed
+	now := time.Now()
+	bookSenses := []domain.Sense{{Gloss: "本", Order: 1}, {Gloss: "書物", Note: "literary", Order: 2}}
+	bookAnnotation := domain.TranslationAnnotation{Labels: []string{"formal"}, Tags: []string{"education"}}
+	book, err := domain.NewTranslationWithDetail(2, now, now, "book", domain.PosNoun, domain.Lang2JA, "書籍", service.TranslationProviderCustom, domain.TranslationDetail{
+		Confidence: 1,
+		Annotation: bookAnnotation,
+	})
+	require.NoError(t, err)
+	customRepo := new(service_mock.CustomTranslationRepository)
+	customRepo.On("FindHistory", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun).Return([]service.CustomTranslationHistory{
+		{Version: 2, Action: service.CustomTranslationActionUpdate, OldTranslated: "本, 書物", NewTranslated: "書籍", NewSenses: []domain.Sense{{Gloss: "書籍", Order: 1}}},
+		{Version: 1, Action: service.CustomTranslationActionAdd, NewTranslated: "本, 書物", NewSenses: bookSenses},
+	}, nil)
+	customRepo.On("FindHistory", anythingOfContext, domain.Lang2JA, "cat", domain.PosNoun).Return([]service.CustomTranslationHistory{
+		{Version: 1, Action: service.CustomTranslationActionRemove, OldTranslated: "猫"},
+		{Version: 1, Action: service.CustomTranslationActionAdd, NewTranslated: "猫", NewSenses: []domain.Sense{{Gloss: "猫", Order: 1}}},
+	}, nil)
+	customRepo.On("FindByTextAndPos", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun).Return(book, nil)
+	customRepo.On("FindByTextAndPos", anythingOfContext, domain.Lang2JA, "cat", domain.PosNoun).Return(nil, service.ErrTranslationNotFound)
+	customRepo.On("Update", anythingOfContext, domain.Lang2JA, "book", domain.PosNoun, mock.Anything).Return(nil)
+	customRepo.On("Add", anythingOfContext, mock.Anything).Return(nil)
+	rf := new(service_mock.RepositoryFactory)
+	transactionManager := new(service_mock.TransactionManager)
+	transactionManager.On("Do", anythingOfContext, mock.Anything).Return(func(ctx context.Context, fn func(service.RepositoryFactory) error) error {
+		return fn(rf)
+	})
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customRepo)
+	adminUsecase := usecase.NewAdminUsecase(rf, transactionManager)
+
+	tests := []struct {
+		name       string
+		text       string
+		toVersion  int
+		version    int
+		assertion  assert.ErrorAssertionFunc
+		wantMethod string
+		wantParam  interface{}
+	}{
+		// - the current annotation is kept
+		{"revert the update", "book", 1, 0, assert.NoError, "Update", mustNewTranslationUpdateParameterWithAnnotation(t, bookSenses, bookAnnotation, 2)},
+		{"revert the update with the version", "book", 1, 2, assert.NoError, "Update", mustNewTranslationUpdateParameterWithAnnotation(t, bookSenses, bookAnnotation, 2)},
+		{"revert the removal", "cat", 1, 0, assert.NoError, "Add", mustNewTranslationAddParameter(t, "cat", "猫")},
+		{"removed by someone else", "cat", 1, 1, matchErrorFunc(service.ErrVersionConflict), "", nil},
+		{"unknown version", "book", 3, 0, matchErrorFunc(service.ErrTranslationNotFound), "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customRepo.Calls = nil
+
+			// when
+			err := adminUsecase.RevertTranslation(bg, domain.Lang2JA, tt.text, domain.PosNoun, tt.toVersion, tt.version)
+
+			// then
+			tt.assertion(t, err)
+			switch tt.wantMethod {
+			case "Update":
+				customRepo.AssertCalled(t, "Update", anythingOfContext, domain.Lang2JA, tt.text, domain.PosNoun, tt.wantParam)
+			case "Add":
+				customRepo.AssertCalled(t, "Add", anythingOfContext, tt.wantParam)
+			default:
+				customRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				customRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func mustNewTranslationAddParameter(t *testing.T, text, translated string) service.TranslationAddParameter {
+	param, err := service.NewTransalationAddParameter(text, domain.PosNoun, domain.Lang2JA, translated)
+	require.NoError(t, err)
+	return param
+}
+
+func mustNewTranslationUpdateParameterWithAnnotation(t *testing.T, senses []domain.Sense, annotation domain.TranslationAnnotation, version int) service.TranslationUpdateParameter {
+	param, err := service.NewTransaltionUpdateParameterWithAnnotation(senses, annotation, version)
+	require.NoError(t, err)
+	return param
+}
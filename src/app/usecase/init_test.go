@@ -4,6 +4,12 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/mock"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 )
 
 var anythingOfContext = mock.MatchedBy(func(_ context.Context) bool { return true })
+
+var enToJaLanguagePairs = []domain.LanguagePair{{FromLang: domain.Lang2EN, ToLang: domain.Lang2JA}}
+
+var enJaLanguagePairs = []domain.LanguagePair{{FromLang: domain.Lang2EN, ToLang: domain.Lang2JA}, {FromLang: domain.Lang2JA, ToLang: domain.Lang2EN}}
@@ -6,9 +6,9 @@ import (
 	context "context"
 
 	domain "github.com/kujilabo/cocotola-translator-api/src/app/domain"
-	mock "github.com/stretchr/testify/mock"
-
 	service "github.com/kujilabo/cocotola-translator-api/src/app/service"
+	usecase "github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+	mock "github.com/stretchr/testify/mock"
 
 	testing "testing"
 )
@@ -32,6 +32,66 @@ func (_m *AdminUsecase) AddTranslation(ctx context.Context, param service.Transl
 	return r0
 }
 
+// ExportTranslations provides a mock function with given fields: ctx, lang2, presenter
+func (_m *AdminUsecase) ExportTranslations(ctx context.Context, lang2 domain.Lang2, presenter usecase.AdminPresenter) error {
+	ret := _m.Called(ctx, lang2, presenter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, usecase.AdminPresenter) error); ok {
+		r0 = rf(ctx, lang2, presenter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindDisabledTranslations provides a mock function with given fields: ctx, lang2
+func (_m *AdminUsecase) FindDisabledTranslations(ctx context.Context, lang2 domain.Lang2) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, lang2)
+
+	var r0 []domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2) []domain.Translation); ok {
+		r0 = rf(ctx, lang2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2) error); ok {
+		r1 = rf(ctx, lang2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindNegativeCaches provides a mock function with given fields: ctx, lang2, condition
+func (_m *AdminUsecase) FindNegativeCaches(ctx context.Context, lang2 domain.Lang2, condition *service.TranslationSearchCondition) (*service.NegativeCacheSearchResult, error) {
+	ret := _m.Called(ctx, lang2, condition)
+
+	var r0 *service.NegativeCacheSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, *service.TranslationSearchCondition) *service.NegativeCacheSearchResult); ok {
+		r0 = rf(ctx, lang2, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.NegativeCacheSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, *service.TranslationSearchCondition) error); ok {
+		r1 = rf(ctx, lang2, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTranslationByText provides a mock function with given fields: ctx, lang2, text
 func (_m *AdminUsecase) FindTranslationByText(ctx context.Context, lang2 domain.Lang2, text string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, lang2, text)
@@ -78,6 +138,29 @@ func (_m *AdminUsecase) FindTranslationByTextAndPos(ctx context.Context, lang2 d
 	return r0, r1
 }
 
+// FindTranslationHistory provides a mock function with given fields: ctx, lang2, text, pos
+func (_m *AdminUsecase) FindTranslationHistory(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) ([]service.CustomTranslationHistory, error) {
+	ret := _m.Called(ctx, lang2, text, pos)
+
+	var r0 []service.CustomTranslationHistory
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos) []service.CustomTranslationHistory); ok {
+		r0 = rf(ctx, lang2, text, pos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.CustomTranslationHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string, domain.WordPos) error); ok {
+		r1 = rf(ctx, lang2, text, pos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTranslationsByFirstLetter provides a mock function with given fields: ctx, lang2, firstLetter
 func (_m *AdminUsecase) FindTranslationsByFirstLetter(ctx context.Context, lang2 domain.Lang2, firstLetter string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, lang2, firstLetter)
@@ -101,13 +184,71 @@ func (_m *AdminUsecase) FindTranslationsByFirstLetter(ctx context.Context, lang2
 	return r0, r1
 }
 
-// RemoveTranslation provides a mock function with given fields: ctx, lang2, text, pos
-func (_m *AdminUsecase) RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos) error {
-	ret := _m.Called(ctx, lang2, text, pos)
+// ImportTranslations provides a mock function with given fields: ctx, rows, dryRun
+func (_m *AdminUsecase) ImportTranslations(ctx context.Context, rows []usecase.TranslationImportRow, dryRun bool) (*usecase.TranslationImportResults, error) {
+	ret := _m.Called(ctx, rows, dryRun)
+
+	var r0 *usecase.TranslationImportResults
+	if rf, ok := ret.Get(0).(func(context.Context, []usecase.TranslationImportRow, bool) *usecase.TranslationImportResults); ok {
+		r0 = rf(ctx, rows, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TranslationImportResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []usecase.TranslationImportRow, bool) error); ok {
+		r1 = rf(ctx, rows, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveNegativeCaches provides a mock function with given fields: ctx, lang2, text
+func (_m *AdminUsecase) RemoveNegativeCaches(ctx context.Context, lang2 domain.Lang2, text string) (int64, error) {
+	ret := _m.Called(ctx, lang2, text)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string) int64); ok {
+		r0 = rf(ctx, lang2, text)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, string) error); ok {
+		r1 = rf(ctx, lang2, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTranslation provides a mock function with given fields: ctx, lang2, text, pos, version
+func (_m *AdminUsecase) RemoveTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	ret := _m.Called(ctx, lang2, text, pos, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos) error); ok {
-		r0 = rf(ctx, lang2, text, pos)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos, int) error); ok {
+		r0 = rf(ctx, lang2, text, pos, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreTranslation provides a mock function with given fields: ctx, lang2, text, pos, version
+func (_m *AdminUsecase) RestoreTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, version int) error {
+	ret := _m.Called(ctx, lang2, text, pos, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos, int) error); ok {
+		r0 = rf(ctx, lang2, text, pos, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -115,6 +256,43 @@ func (_m *AdminUsecase) RemoveTranslation(ctx context.Context, lang2 domain.Lang
 	return r0
 }
 
+// RevertTranslation provides a mock function with given fields: ctx, lang2, text, pos, toVersion, version
+func (_m *AdminUsecase) RevertTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, toVersion int, version int) error {
+	ret := _m.Called(ctx, lang2, text, pos, toVersion, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, string, domain.WordPos, int, int) error); ok {
+		r0 = rf(ctx, lang2, text, pos, toVersion, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchTranslations provides a mock function with given fields: ctx, lang2, condition
+func (_m *AdminUsecase) SearchTranslations(ctx context.Context, lang2 domain.Lang2, condition *service.CustomTranslationSearchCondition) (*service.CustomTranslationSearchResult, error) {
+	ret := _m.Called(ctx, lang2, condition)
+
+	var r0 *service.CustomTranslationSearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, *service.CustomTranslationSearchCondition) *service.CustomTranslationSearchResult); ok {
+		r0 = rf(ctx, lang2, condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.CustomTranslationSearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, *service.CustomTranslationSearchCondition) error); ok {
+		r1 = rf(ctx, lang2, condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTranslation provides a mock function with given fields: ctx, lang2, text, pos, param
 func (_m *AdminUsecase) UpdateTranslation(ctx context.Context, lang2 domain.Lang2, text string, pos domain.WordPos, param service.TranslationUpdateParameter) error {
 	ret := _m.Called(ctx, lang2, text, pos, param)
@@ -16,6 +16,29 @@ type UserUsecase struct {
 	mock.Mock
 }
 
+// DictionaryExamples provides a mock function with given fields: ctx, fromLang, toLang, text, translated
+func (_m *UserUsecase) DictionaryExamples(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, text string, translated string) ([]domain.Example, error) {
+	ret := _m.Called(ctx, fromLang, toLang, text, translated)
+
+	var r0 []domain.Example
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, domain.Lang2, string, string) []domain.Example); ok {
+		r0 = rf(ctx, fromLang, toLang, text, translated)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Example)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, domain.Lang2, string, string) error); ok {
+		r1 = rf(ctx, fromLang, toLang, text, translated)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DictionaryLookup provides a mock function with given fields: ctx, fromLang, toLang, text
 func (_m *UserUsecase) DictionaryLookup(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, text string) ([]domain.Translation, error) {
 	ret := _m.Called(ctx, fromLang, toLang, text)
@@ -39,6 +62,52 @@ func (_m *UserUsecase) DictionaryLookup(ctx context.Context, fromLang domain.Lan
 	return r0, r1
 }
 
+// DictionaryLookupBatch provides a mock function with given fields: ctx, fromLang, toLang, texts
+func (_m *UserUsecase) DictionaryLookupBatch(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, texts []string) (map[string][]domain.Translation, error) {
+	ret := _m.Called(ctx, fromLang, toLang, texts)
+
+	var r0 map[string][]domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, domain.Lang2, []string) map[string][]domain.Translation); ok {
+		r0 = rf(ctx, fromLang, toLang, texts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, domain.Lang2, []string) error); ok {
+		r1 = rf(ctx, fromLang, toLang, texts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DictionaryLookupCandidates provides a mock function with given fields: ctx, fromLang, toLang, text
+func (_m *UserUsecase) DictionaryLookupCandidates(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, text string) ([]domain.TranslationCandidate, error) {
+	ret := _m.Called(ctx, fromLang, toLang, text)
+
+	var r0 []domain.TranslationCandidate
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, domain.Lang2, string) []domain.TranslationCandidate); ok {
+		r0 = rf(ctx, fromLang, toLang, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TranslationCandidate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, domain.Lang2, string) error); ok {
+		r1 = rf(ctx, fromLang, toLang, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DictionaryLookupWithPos provides a mock function with given fields: ctx, fromLang, toLang, text, pos
 func (_m *UserUsecase) DictionaryLookupWithPos(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error) {
 	ret := _m.Called(ctx, fromLang, toLang, text, pos)
@@ -62,6 +131,52 @@ func (_m *UserUsecase) DictionaryLookupWithPos(ctx context.Context, fromLang dom
 	return r0, r1
 }
 
+// DictionaryReverseLookup provides a mock function with given fields: ctx, fromLang, toLang, translated, prefix
+func (_m *UserUsecase) DictionaryReverseLookup(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, translated string, prefix bool) ([]domain.Translation, error) {
+	ret := _m.Called(ctx, fromLang, toLang, translated, prefix)
+
+	var r0 []domain.Translation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, domain.Lang2, string, bool) []domain.Translation); ok {
+		r0 = rf(ctx, fromLang, toLang, translated, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Translation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, domain.Lang2, string, bool) error); ok {
+		r1 = rf(ctx, fromLang, toLang, translated, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Translate provides a mock function with given fields: ctx, fromLang, toLang, text
+func (_m *UserUsecase) Translate(ctx context.Context, fromLang domain.Lang2, toLang domain.Lang2, text string) (domain.TextTranslation, error) {
+	ret := _m.Called(ctx, fromLang, toLang, text)
+
+	var r0 domain.TextTranslation
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Lang2, domain.Lang2, string) domain.TextTranslation); ok {
+		r0 = rf(ctx, fromLang, toLang, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.TextTranslation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Lang2, domain.Lang2, string) error); ok {
+		r1 = rf(ctx, fromLang, toLang, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUserUsecase creates a new instance of UserUsecase. It also registers a cleanup function to assert the mocks expectations.
 func NewUserUsecase(t testing.TB) *UserUsecase {
 	mock := &UserUsecase{}
@@ -0,0 +1,156 @@
+//go:generate mockery --output mock --name RefreshUsecase
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
+)
+
+type RefreshUsecase interface {
+	// RefreshStaleTranslations looks up again at most limit cached translations of each source language of each cached provider which have not been refreshed since refreshedBefore.
+	// It returns the number of the refreshed texts.
+	RefreshStaleTranslations(ctx context.Context, refreshedBefore time.Time, limit int) (int, error)
+}
+
+type refreshUsecase struct {
+	rf    service.RepositoryFactory
+	chain []service.TranslationProviderChainItem
+}
+
+// NewRefreshUsecase returns the usecase which refreshes the caches of the cached providers in chain
+func NewRefreshUsecase(rf service.RepositoryFactory, chain []service.TranslationProviderChainItem) RefreshUsecase {
+	return &refreshUsecase{
+		rf:    rf,
+		chain: chain,
+	}
+}
+
+func (u *refreshUsecase) RefreshStaleTranslations(ctx context.Context, refreshedBefore time.Time, limit int) (int, error) {
+	refreshed := 0
+	for _, p := range u.chain {
+		if !p.Cached {
+			continue
+		}
+
+		for _, fromLang := range sourceLanguages(p.Provider.SupportedLanguagePairs()) {
+			n, err := u.refreshProvider(ctx, p, fromLang, refreshedBefore, limit)
+			refreshed += n
+			if err != nil {
+				return refreshed, err
+			}
+		}
+	}
+	return refreshed, nil
+}
+
+func (u *refreshUsecase) refreshProvider(ctx context.Context, p service.TranslationProviderChainItem, fromLang domain.Lang2, refreshedBefore time.Time, limit int) (int, error) {
+	logger := log.FromContext(ctx)
+
+	cacheRepo, err := u.rf.NewTranslationCacheRepository(ctx, p.Name, fromLang)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := cacheRepo.FindStale(ctx, refreshedBefore, limit)
+	if err != nil {
+		return 0, liberrors.Errorf("failed to FindStale in refreshUsecase.refreshProvider. provider: %s, err: %w", p.Name, err)
+	}
+
+	refreshed := 0
+	for _, e := range entries {
+		// the provider may have stopped supporting the pair since the text was cached
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, e.Lang2) {
+			logger.Warnf("unsupported language pair. provider: %s, pair: %s-%s, text: %s", p.Name, fromLang.String(), e.Lang2.String(), e.Text)
+			if err := u.postpone(ctx, cacheRepo, p.Name, e); err != nil {
+				return refreshed, err
+			}
+			continue
+		}
+
+		results, err := p.Provider.DictionaryLookup(ctx, e.Text, fromLang, e.Lang2)
+		if err != nil {
+			logger.Warnf("failed to DictionaryLookup. provider: %s, text: %s, err: %v", p.Name, e.Text, err)
+			if err := u.postpone(ctx, cacheRepo, p.Name, e); err != nil {
+				return refreshed, err
+			}
+			continue
+		}
+
+		added, removed := diffTranslations(e.Results, results)
+		if len(added) > 0 || len(removed) > 0 {
+			logger.Infof("translations changed. provider: %s, lang2: %s, text: %s, added: %v, removed: %v", p.Name, e.Lang2.String(), e.Text, added, removed)
+		}
+
+		// keep the cached translations if the provider has lost all of them, which is more likely a temporary failure than a correction
+		if len(results) == 0 {
+			results = e.Results
+		}
+
+		if err := cacheRepo.Refresh(ctx, e.Lang2, e.Text, results); err != nil {
+			return refreshed, liberrors.Errorf("failed to Refresh in refreshUsecase.refreshProvider. provider: %s, text: %s, err: %w", p.Name, e.Text, err)
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+// postpone updates the refreshed time of the entry which could not be refreshed so that it does not block the other stale entries
+func (u *refreshUsecase) postpone(ctx context.Context, cacheRepo service.TranslationCacheRepository, providerName string, e service.TranslationCacheEntry) error {
+	if err := cacheRepo.Touch(ctx, e.Lang2, e.Text); err != nil {
+		return liberrors.Errorf("failed to Touch in refreshUsecase.refreshProvider. provider: %s, text: %s, err: %w", providerName, e.Text, err)
+	}
+	return nil
+}
+
+// sourceLanguages returns the distinct source languages of pairs in order
+func sourceLanguages(pairs []domain.LanguagePair) []domain.Lang2 {
+	exists := make(map[string]bool)
+	results := make([]domain.Lang2, 0)
+	for _, pair := range pairs {
+		if exists[pair.FromLang.String()] {
+			continue
+		}
+		exists[pair.FromLang.String()] = true
+		results = append(results, pair.FromLang)
+	}
+	return results
+}
+
+// diffTranslations returns the "pos:target"s which are in newResults but not in oldResults and vice versa. Confidences are ignored.
+func diffTranslations(oldResults, newResults []service.TranslationCandidate) ([]string, []string) {
+	makeKey := func(t service.TranslationCandidate) string {
+		return strconv.Itoa(int(t.Pos)) + ":" + t.Target
+	}
+	toSet := func(results []service.TranslationCandidate) map[string]bool {
+		set := make(map[string]bool)
+		for _, r := range results {
+			set[makeKey(r)] = true
+		}
+		return set
+	}
+	oldSet := toSet(oldResults)
+	newSet := toSet(newResults)
+
+	added := make([]string, 0)
+	for key := range newSet {
+		if !oldSet[key] {
+			added = append(added, key)
+		}
+	}
+	removed := make([]string, 0)
+	for key := range oldSet {
+		if !newSet[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
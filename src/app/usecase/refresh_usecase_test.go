@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
+	service_mock "github.com/kujilabo/cocotola-translator-api/src/app/service/mock"
+	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
+)
+
+func Test_refreshUsecase_RefreshStaleTranslations(t *testing.T) {
+	bg := context.Background()
+	refreshedBefore := time.Now().Add(-time.Hour)
+
+	// given
+	// - the chain is azure(cached) -> offline
+	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", bg, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	offlineProvider := new(service_mock.TranslationProvider)
+	refreshUsecase := usecase.NewRefreshUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+		{Name: "offline", Provider: offlineProvider, Cached: false},
+	})
+	// - "book" and "pen" are stale
+	bookOld := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 0.5}}
+	penOld := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "ペン", Confidence: 1}}
+	azureTranslationRepo.On("FindStale", bg, refreshedBefore, 10).Return([]service.TranslationCacheEntry{
+		{Lang2: domain.Lang2JA, Text: "book", Results: bookOld},
+		{Lang2: domain.Lang2JA, Text: "pen", Results: penOld},
+	}, nil)
+	// - azure has a new translation of "book" and has lost "pen"
+	bookNew := []service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "本", Confidence: 0.6},
+		{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.4},
+	}
+	azureTranslationClient.On("DictionaryLookup", bg, "book", domain.Lang2EN, domain.Lang2JA).Return(bookNew, nil)
+	azureTranslationClient.On("DictionaryLookup", bg, "pen", domain.Lang2EN, domain.Lang2JA).Return([]service.TranslationCandidate{}, nil)
+	azureTranslationRepo.On("Refresh", bg, domain.Lang2JA, "book", bookNew).Return(nil)
+	azureTranslationRepo.On("Refresh", bg, domain.Lang2JA, "pen", penOld).Return(nil)
+
+	// when
+	refreshed, err := refreshUsecase.RefreshStaleTranslations(bg, refreshedBefore, 10)
+
+	// then
+	// - the translations of "pen" are kept
+	require.NoError(t, err)
+	assert.Equal(t, 2, refreshed)
+	azureTranslationRepo.AssertExpectations(t)
+	offlineProvider.AssertNotCalled(t, "DictionaryLookup")
+}
+
+func Test_refreshUsecase_RefreshStaleTranslations_failure(t *testing.T) {
+	bg := context.Background()
+	refreshedBefore := time.Now().Add(-time.Hour)
+
+	// given
+	// - the chain is azure(cached, en-ja)
+	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", bg, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	refreshUsecase := usecase.NewRefreshUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	})
+	// - "book", "pen" and "本" are stale
+	bookOld := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 1}}
+	penOld := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "ペン", Confidence: 1}}
+	honOld := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "book", Confidence: 1}}
+	azureTranslationRepo.On("FindStale", bg, refreshedBefore, 10).Return([]service.TranslationCacheEntry{
+		{Lang2: domain.Lang2JA, Text: "book", Results: bookOld},
+		{Lang2: domain.Lang2EN, Text: "本", Results: honOld},
+		{Lang2: domain.Lang2JA, Text: "pen", Results: penOld},
+	}, nil)
+	// - azure fails to look up "book"
+	azureTranslationClient.On("DictionaryLookup", bg, "book", domain.Lang2EN, domain.Lang2JA).Return(nil, errors.New("service unavailable"))
+	azureTranslationClient.On("DictionaryLookup", bg, "pen", domain.Lang2EN, domain.Lang2JA).Return(penOld, nil)
+	azureTranslationRepo.On("Refresh", bg, domain.Lang2JA, "pen", penOld).Return(nil)
+	azureTranslationRepo.On("Touch", bg, domain.Lang2JA, "book").Return(nil)
+	azureTranslationRepo.On("Touch", bg, domain.Lang2EN, "本").Return(nil)
+
+	// when
+	refreshed, err := refreshUsecase.RefreshStaleTranslations(bg, refreshedBefore, 10)
+
+	// then
+	// - "pen" is refreshed after the failure of "book"
+	// - "本" is not looked up because azure does not support en-en
+	// - the refreshed times of "book" and "本" are updated
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	azureTranslationRepo.AssertExpectations(t)
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", bg, "本", domain.Lang2JA, domain.Lang2EN)
+}
+
+func Test_refreshUsecase_RefreshStaleTranslations_sourceLanguages(t *testing.T) {
+	bg := context.Background()
+	refreshedBefore := time.Now().Add(-time.Hour)
+	lang2DE, err := domain.NewLang2("de")
+	require.NoError(t, err)
+
+	// given
+	// - the provider translates english and german into japanese
+	enRepo := new(service_mock.TranslationCacheRepository)
+	deRepo := new(service_mock.TranslationCacheRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", bg, service.TranslationProviderAzure, domain.Lang2EN).Return(enRepo, nil)
+	rf.On("NewTranslationCacheRepository", bg, service.TranslationProviderAzure, lang2DE).Return(deRepo, nil)
+	provider := new(service_mock.TranslationProvider)
+	provider.On("SupportedLanguagePairs").Return([]domain.LanguagePair{
+		{FromLang: domain.Lang2EN, ToLang: domain.Lang2JA},
+		{FromLang: lang2DE, ToLang: domain.Lang2JA},
+	})
+	refreshUsecase := usecase.NewRefreshUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: provider, Cached: true},
+	})
+	// - "die" is stale in both languages
+	enDie := []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "死ぬ", Confidence: 1}}
+	deDie := []service.TranslationCandidate{{Pos: domain.PosOther, Target: "その", Confidence: 1}}
+	enRepo.On("FindStale", bg, refreshedBefore, 10).Return([]service.TranslationCacheEntry{{Lang2: domain.Lang2JA, Text: "die", Results: enDie}}, nil)
+	deRepo.On("FindStale", bg, refreshedBefore, 10).Return([]service.TranslationCacheEntry{{Lang2: domain.Lang2JA, Text: "die", Results: deDie}}, nil)
+	provider.On("DictionaryLookup", bg, "die", domain.Lang2EN, domain.Lang2JA).Return(enDie, nil)
+	provider.On("DictionaryLookup", bg, "die", lang2DE, domain.Lang2JA).Return(deDie, nil)
+	enRepo.On("Refresh", bg, domain.Lang2JA, "die", enDie).Return(nil)
+	deRepo.On("Refresh", bg, domain.Lang2JA, "die", deDie).Return(nil)
+
+	// when
+	refreshed, err := refreshUsecase.RefreshStaleTranslations(bg, refreshedBefore, 10)
+
+	// then
+	// - each text is looked up again in its own source language
+	require.NoError(t, err)
+	assert.Equal(t, 2, refreshed)
+	enRepo.AssertExpectations(t)
+	deRepo.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
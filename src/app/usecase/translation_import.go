@@ -0,0 +1,43 @@
+package usecase
+
+type TranslationImportStatus string
+
+const (
+	TranslationImportStatusCreated   TranslationImportStatus = "created"
+	TranslationImportStatusUpdated   TranslationImportStatus = "updated"
+	TranslationImportStatusUnchanged TranslationImportStatus = "unchanged"
+	TranslationImportStatusInvalid   TranslationImportStatus = "invalid"
+)
+
+// TranslationImportRow is a raw row of an imported file. It is validated by AdminUsecase.ImportTranslations.
+// The rows whose Translated is empty are invalid.
+type TranslationImportRow struct {
+	Lang2      string
+	Text       string
+	Pos        int
+	Translated string
+}
+
+type TranslationImportResult struct {
+	RowNo   int
+	Lang2   string
+	Text    string
+	Pos     int
+	Status  TranslationImportStatus
+	Message string
+}
+
+type TranslationImportResults struct {
+	DryRun    bool
+	Committed bool
+	Results   []TranslationImportResult
+}
+
+func (r *TranslationImportResults) HasInvalidRows() bool {
+	for _, result := range r.Results {
+		if result.Status == TranslationImportStatusInvalid {
+			return true
+		}
+	}
+	return false
+}
@@ -8,20 +8,76 @@ import (
 	"strconv"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
+	"github.com/kujilabo/cocotola-translator-api/src/lib/log"
 )
 
 type UserUsecase interface {
 	DictionaryLookup(ctx context.Context, fromLang, toLang domain.Lang2, text string) ([]domain.Translation, error)
 
 	DictionaryLookupWithPos(ctx context.Context, fromLang, toLang domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error)
+
+	// DictionaryLookupCandidates returns all the translations of the text ranked for each pos in pos order.
+	// The custom translation of a pos is always ranked first.
+	DictionaryLookupCandidates(ctx context.Context, fromLang, toLang domain.Lang2, text string) ([]domain.TranslationCandidate, error)
+
+	// DictionaryLookupBatch returns the translations keyed by text. Every text in texts has its entry even if no translations are found.
+	DictionaryLookupBatch(ctx context.Context, fromLang, toLang domain.Lang2, texts []string) (map[string][]domain.Translation, error)
+
+	// DictionaryReverseLookup returns the translations of fromLang texts whose toLang translations are equal to translated, or start with translated if prefix is true.
+	// Custom translations and caches are searched first and the providers are called in the reverse direction if nothing is found. The results are in confidence order.
+	DictionaryReverseLookup(ctx context.Context, fromLang, toLang domain.Lang2, translated string, prefix bool) ([]domain.Translation, error)
+
+	// DictionaryExamples returns the example sentences of the text and its translation. They are cached once they are looked up.
+	DictionaryExamples(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string) ([]domain.Example, error)
+
+	// Translate translates the free text. The language of the text is detected if fromLang is nil. Translations are cached.
+	Translate(ctx context.Context, fromLang, toLang domain.Lang2, text string) (domain.TextTranslation, error)
 }
 
 type userUsecase struct {
-	rf                     service.RepositoryFactory
-	azureTranslationClient service.AzureTranslationClient
+	rf    service.RepositoryFactory
+	chain []service.TranslationProviderChainItem
+	// exampleProvider is nil if no provider has examples
+	exampleProvider service.ExampleProvider
+	// textTranslator is nil if no provider translates free texts
+	textTranslator service.TextTranslator
+	// lookupGroup coalesces the in-flight provider lookups of the same text
+	lookupGroup singleflight.Group
+}
+
+// coalescedLookupTimeout is the deadline of the provider lookup shared by the concurrent callers.
+// It is independent of the callers so that the lookup is not canceled when the first caller leaves.
+const coalescedLookupTimeout = 30 * time.Second
+
+// detachedContext has the values of the parent such as the logger and the span, but is neither canceled nor timed out with it
+type detachedContext struct {
+	parent context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (c detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+type providerLookupResult struct {
+	providerName string
+	results      []service.TranslationCandidate
 }
 
 type UserPresenter interface {
@@ -29,15 +85,29 @@ type UserPresenter interface {
 	WriteTranslation(ctx context.Context, translation domain.Translation) error
 }
 
-func NewUserUsecase(rf service.RepositoryFactory, azureTranslationClient service.AzureTranslationClient) UserUsecase {
+// NewUserUsecase returns the usecase which looks up custom translations first, then the providers in chain.
+// Examples are looked up from exampleProvider and free texts are translated by textTranslator. The methods using them fail if they are nil.
+func NewUserUsecase(rf service.RepositoryFactory, chain []service.TranslationProviderChainItem, exampleProvider service.ExampleProvider, textTranslator service.TextTranslator) UserUsecase {
 	return &userUsecase{
-		rf:                     rf,
-		azureTranslationClient: azureTranslationClient,
+		rf:              rf,
+		chain:           chain,
+		exampleProvider: exampleProvider,
+		textTranslator:  textTranslator,
+	}
+}
+
+// checkLanguagePair returns ErrUnsupportedLanguagePair unless a provider in the chain supports the pair
+func (u *userUsecase) checkLanguagePair(fromLang, toLang domain.Lang2) error {
+	for _, p := range u.chain {
+		if domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			return nil
+		}
 	}
+	return liberrors.Errorf("no provider supports the language pair. pair: %s-%s, err: %w", fromLang.String(), toLang.String(), service.ErrUnsupportedLanguagePair)
 }
 
-func (u *userUsecase) selectMaxConfidenceTranslations(ctx context.Context, in []service.AzureTranslation) (map[domain.WordPos]service.AzureTranslation, error) {
-	results := make(map[domain.WordPos]service.AzureTranslation)
+func (u *userUsecase) selectMaxConfidenceTranslations(ctx context.Context, in []service.TranslationCandidate) (map[domain.WordPos]service.TranslationCandidate, error) {
+	results := make(map[domain.WordPos]service.TranslationCandidate)
 	for _, i := range in {
 		if _, ok := results[i.Pos]; !ok {
 			results[i.Pos] = i
@@ -70,42 +140,138 @@ func (u *userUsecase) customDictionaryLookup(ctx context.Context, text string, f
 	return customResults, nil
 }
 
-func (u *userUsecase) azureDictionaryLookup(ctx context.Context, fromLang, toLang domain.Lang2, text string) ([]service.AzureTranslation, error) {
-	// repo, err := t.repo(t.db)
-	// if err != nil {
-	// 	return nil, err
-	// }
+// coalescedProviderDictionaryLookup calls providerDictionaryLookup only once for the concurrent lookups of the same (fromLang, toLang, text).
+// The callers share the result of the lookup, which runs on the context detached from the first caller. Each caller stops waiting when its own context is done.
+func (u *userUsecase) coalescedProviderDictionaryLookup(ctx context.Context, fromLang, toLang domain.Lang2, text string) (string, []service.TranslationCandidate, error) {
+	key := fromLang.String() + "_" + toLang.String() + "_" + text
+	ch := u.lookupGroup.DoChan(key, func() (interface{}, error) {
+		lookupCtx, cancel := context.WithTimeout(detachedContext{parent: ctx}, coalescedLookupTimeout)
+		defer cancel()
 
-	azureRepo := u.rf.NewAzureTranslationRepository(ctx)
-	azureContained, err := azureRepo.Contain(ctx, toLang, text)
-	if err != nil {
-		return nil, err
-	}
-	if azureContained {
-		azureResults, err := azureRepo.Find(ctx, toLang, text)
+		providerName, results, err := u.providerDictionaryLookup(lookupCtx, fromLang, toLang, text)
 		if err != nil {
 			return nil, err
 		}
-		return azureResults, nil
+		return &providerLookupResult{providerName: providerName, results: results}, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return "", nil, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return "", nil, r.Err
+		}
+		result := r.Val.(*providerLookupResult)
+		return result.providerName, result.results, nil
 	}
+}
 
-	azureResults, err := u.azureTranslationClient.DictionaryLookup(ctx, text, fromLang, toLang)
-	if err != nil {
-		return nil, err
+// providerDictionaryLookup walks the provider chain and returns the name of the provider which answered.
+// The caches of all the cached providers are checked before any live provider is called.
+// A provider whose cache has a negative entry of the text is not called.
+// Providers which do not support the language pair are skipped.
+func (u *userUsecase) providerDictionaryLookup(ctx context.Context, fromLang, toLang domain.Lang2, text string) (string, []service.TranslationCandidate, error) {
+	logger := log.FromContext(ctx)
+
+	negativeCached := make(map[string]bool)
+
+	// find translations from caches
+	for _, p := range u.chain {
+		if !p.Cached {
+			continue
+		}
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			continue
+		}
+
+		cacheRepo, err := u.rf.NewTranslationCacheRepository(ctx, p.Name, fromLang)
+		if err != nil {
+			return "", nil, err
+		}
+
+		contained, err := cacheRepo.Contain(ctx, toLang, text)
+		if err != nil {
+			return "", nil, err
+		}
+		if !contained {
+			continue
+		}
+
+		cachedResults, err := cacheRepo.Find(ctx, toLang, text)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(cachedResults) == 0 {
+			negativeCached[p.Name] = true
+			continue
+		}
+		return p.Name, cachedResults, nil
+	}
+
+	// find translations from live providers
+	var lastErr error
+	for _, p := range u.chain {
+		if negativeCached[p.Name] {
+			continue
+		}
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			continue
+		}
+
+		results, err := p.Provider.DictionaryLookup(ctx, text, fromLang, toLang)
+		if err != nil {
+			logger.Warnf("failed to DictionaryLookup. provider: %s, err: %v", p.Name, err)
+			lastErr = err
+			continue
+		}
+
+		if p.Cached {
+			if err := u.addTranslationCache(ctx, p.Name, fromLang, toLang, text, results); err != nil {
+				return "", nil, err
+			}
+		}
+
+		if len(results) == 0 {
+			continue
+		}
+
+		return p.Name, results, nil
 	}
 
-	if len(azureResults) == 0 {
-		return azureResults, nil
+	if lastErr != nil {
+		return "", nil, lastErr
 	}
+	return "", nil, nil
+}
 
-	if err := azureRepo.Add(ctx, toLang, text, azureResults); err != nil {
-		return nil, liberrors.Errorf("failed to add auzre_translation. err: %w", err)
+// addTranslationCache stores the results of the provider in its cache. Empty results are stored as a negative entry.
+// The entry which has been added by a concurrent request is regarded as added.
+func (u *userUsecase) addTranslationCache(ctx context.Context, providerName string, fromLang, toLang domain.Lang2, text string, results []service.TranslationCandidate) error {
+	cacheRepo, err := u.rf.NewTranslationCacheRepository(ctx, providerName, fromLang)
+	if err != nil {
+		return err
 	}
 
-	return azureResults, nil
+	if len(results) == 0 {
+		err = cacheRepo.AddNegative(ctx, toLang, text)
+	} else {
+		err = cacheRepo.Add(ctx, toLang, text, results)
+	}
+	if errors.Is(err, service.ErrAzureTranslationAlreadyExists) {
+		return nil
+	}
+	if err != nil {
+		return liberrors.Errorf("failed to add translation cache. provider: %s, err: %w", providerName, err)
+	}
+	return nil
 }
 
 func (u *userUsecase) DictionaryLookup(ctx context.Context, fromLang, toLang domain.Lang2, text string) ([]domain.Translation, error) {
+	if err := u.checkLanguagePair(fromLang, toLang); err != nil {
+		return nil, err
+	}
+
 	// find translations from custom reopository
 	customResults, err := u.customDictionaryLookup(ctx, text, fromLang, toLang)
 	if err != nil && !errors.Is(err, service.ErrTranslationNotFound) {
@@ -115,12 +281,18 @@ func (u *userUsecase) DictionaryLookup(ctx context.Context, fromLang, toLang dom
 	// 	return customResults, err
 	// }
 
-	// find translations from azure
-	azureResults, err := u.azureDictionaryLookup(ctx, fromLang, toLang, text)
+	// find translations from providers
+	providerName, providerResults, err := u.coalescedProviderDictionaryLookup(ctx, fromLang, toLang, text)
 	if err != nil {
 		return nil, err
 	}
-	azureResultMap, err := u.selectMaxConfidenceTranslations(ctx, azureResults)
+
+	return u.mergeTranslations(ctx, toLang, text, customResults, providerName, providerResults)
+}
+
+// mergeTranslations merges the custom translations and the best translations of the provider for each pos. Custom translations take precedence.
+func (u *userUsecase) mergeTranslations(ctx context.Context, toLang domain.Lang2, text string, customResults []domain.Translation, providerName string, providerResults []service.TranslationCandidate) ([]domain.Translation, error) {
+	providerResultMap, err := u.selectMaxConfidenceTranslations(ctx, providerResults)
 	if err != nil {
 		return nil, err
 	}
@@ -135,11 +307,11 @@ func (u *userUsecase) DictionaryLookup(ctx context.Context, fromLang, toLang dom
 		resultMap[key] = c
 	}
 
-	// insert azureResultMap into resultMap
-	for _, a := range azureResultMap {
+	// insert providerResultMap into resultMap
+	for _, a := range providerResultMap {
 		key := makeKey(text, a.Pos)
 		if _, ok := resultMap[key]; !ok {
-			result, err := domain.NewTranslation(1, time.Now(), time.Now(), text, a.Pos, fromLang, a.Target, "azure")
+			result, err := a.ToProviderTranslation(providerName, toLang, text)
 			if err != nil {
 				return nil, err
 			}
@@ -147,9 +319,12 @@ func (u *userUsecase) DictionaryLookup(ctx context.Context, fromLang, toLang dom
 		}
 	}
 
-	// convert map to list
+	// convert map to list. disabled custom translations suppress the translations of the provider
 	results := make([]domain.Translation, 0)
 	for _, v := range resultMap {
+		if v.IsDisabled() {
+			continue
+		}
 		results = append(results, v)
 	}
 
@@ -158,6 +333,231 @@ func (u *userUsecase) DictionaryLookup(ctx context.Context, fromLang, toLang dom
 	return results, nil
 }
 
+func (u *userUsecase) DictionaryLookupCandidates(ctx context.Context, fromLang, toLang domain.Lang2, text string) ([]domain.TranslationCandidate, error) {
+	if err := u.checkLanguagePair(fromLang, toLang); err != nil {
+		return nil, err
+	}
+
+	// find translations from custom reopository
+	customResults, err := u.customDictionaryLookup(ctx, text, fromLang, toLang)
+	if err != nil && !errors.Is(err, service.ErrTranslationNotFound) {
+		return nil, err
+	}
+
+	// find translations from providers
+	providerName, providerResults, err := u.coalescedProviderDictionaryLookup(ctx, fromLang, toLang, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.rankTranslations(ctx, toLang, text, customResults, providerName, providerResults)
+}
+
+// rankTranslations ranks the translations of the provider in confidence order for each pos. The custom translation of a pos takes the first rank.
+// The pos which has a disabled custom translation has no candidates.
+func (u *userUsecase) rankTranslations(ctx context.Context, toLang domain.Lang2, text string, customResults []domain.Translation, providerName string, providerResults []service.TranslationCandidate) ([]domain.TranslationCandidate, error) {
+	customMap := make(map[domain.WordPos]domain.Translation)
+	for _, c := range customResults {
+		customMap[c.GetPos()] = c
+	}
+
+	providerMap := make(map[domain.WordPos][]service.TranslationCandidate)
+	for _, a := range providerResults {
+		providerMap[a.Pos] = append(providerMap[a.Pos], a)
+	}
+
+	poses := make([]domain.WordPos, 0)
+	for pos := range customMap {
+		poses = append(poses, pos)
+	}
+	for pos := range providerMap {
+		if _, ok := customMap[pos]; !ok {
+			poses = append(poses, pos)
+		}
+	}
+	sort.Slice(poses, func(i, j int) bool { return poses[i] < poses[j] })
+
+	results := make([]domain.TranslationCandidate, 0)
+	for _, pos := range poses {
+		rank := 1
+		custom, hasCustom := customMap[pos]
+		if hasCustom && custom.IsDisabled() {
+			// all the candidates of the pos are suppressed
+			continue
+		}
+		if hasCustom {
+			candidate, err := domain.NewTranslationCandidate(custom, rank)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, candidate)
+			rank++
+		}
+
+		candidates := providerMap[pos]
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Confidence > candidates[j].Confidence })
+		for _, a := range candidates {
+			if hasCustom && a.Target == custom.GetTranslated() {
+				continue
+			}
+			translation, err := a.ToProviderTranslation(providerName, toLang, text)
+			if err != nil {
+				return nil, err
+			}
+			candidate, err := domain.NewTranslationCandidate(translation, rank)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, candidate)
+			rank++
+		}
+	}
+	return results, nil
+}
+
+func (u *userUsecase) DictionaryLookupBatch(ctx context.Context, fromLang, toLang domain.Lang2, texts []string) (map[string][]domain.Translation, error) {
+	if err := u.checkLanguagePair(fromLang, toLang); err != nil {
+		return nil, err
+	}
+
+	texts = uniqueTexts(texts)
+
+	// find translations from custom reopository with one query
+	customTranslations, err := u.rf.NewCustomTranslationRepository(ctx).FindByTexts(ctx, toLang, texts)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to FindByTexts in userUsecase.DictionaryLookupBatch. err: %w", err)
+	}
+	customResults := make(map[string][]domain.Translation)
+	for _, c := range customTranslations {
+		customResults[c.GetText()] = append(customResults[c.GetText()], c)
+	}
+
+	// find translations from providers
+	providerNames, providerResults, err := u.providerDictionaryLookupBatch(ctx, fromLang, toLang, texts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string][]domain.Translation)
+	for _, text := range texts {
+		merged, err := u.mergeTranslations(ctx, toLang, text, customResults[text], providerNames[text], providerResults[text])
+		if err != nil {
+			return nil, err
+		}
+		results[text] = merged
+	}
+	return results, nil
+}
+
+// providerDictionaryLookupBatch is the batch version of providerDictionaryLookup. It returns the names of the providers which answered and their translations keyed by text.
+// Each cache is checked with one query and only the texts which are not found are passed to the next provider.
+func (u *userUsecase) providerDictionaryLookupBatch(ctx context.Context, fromLang, toLang domain.Lang2, texts []string) (map[string]string, map[string][]service.TranslationCandidate, error) {
+	logger := log.FromContext(ctx)
+
+	providerNames := make(map[string]string)
+	results := make(map[string][]service.TranslationCandidate)
+	remaining := texts
+	// negativeCached[providerName][text] is true if the cache of the provider has a negative entry of the text
+	negativeCached := make(map[string]map[string]bool)
+
+	// find translations from caches
+	for _, p := range u.chain {
+		if !p.Cached || len(remaining) == 0 {
+			continue
+		}
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			continue
+		}
+
+		cacheRepo, err := u.rf.NewTranslationCacheRepository(ctx, p.Name, fromLang)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cachedResults, err := cacheRepo.FindByTexts(ctx, toLang, remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		negativeCached[p.Name] = make(map[string]bool)
+		for text, translations := range cachedResults {
+			if len(translations) == 0 {
+				negativeCached[p.Name][text] = true
+			}
+		}
+
+		remaining = u.assignProviderResults(p.Name, remaining, cachedResults, providerNames, results)
+	}
+
+	// find translations from live providers
+	var lastErr error
+	for _, p := range u.chain {
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			continue
+		}
+
+		targets := make([]string, 0, len(remaining))
+		for _, text := range remaining {
+			if !negativeCached[p.Name][text] {
+				targets = append(targets, text)
+			}
+		}
+		if len(targets) == 0 {
+			continue
+		}
+
+		liveResults, err := p.Provider.DictionaryLookupBatch(ctx, targets, fromLang, toLang)
+		if err != nil {
+			logger.Warnf("failed to DictionaryLookupBatch. provider: %s, err: %v", p.Name, err)
+			lastErr = err
+			continue
+		}
+
+		if p.Cached {
+			for _, text := range targets {
+				if err := u.addTranslationCache(ctx, p.Name, fromLang, toLang, text, liveResults[text]); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+
+		remaining = u.assignProviderResults(p.Name, remaining, liveResults, providerNames, results)
+	}
+
+	if len(remaining) > 0 && lastErr != nil {
+		return nil, nil, lastErr
+	}
+	return providerNames, results, nil
+}
+
+// assignProviderResults moves the texts which have translations in providerResults into results and returns the rest
+func (u *userUsecase) assignProviderResults(providerName string, texts []string, providerResults map[string][]service.TranslationCandidate, providerNames map[string]string, results map[string][]service.TranslationCandidate) []string {
+	remaining := make([]string, 0, len(texts))
+	for _, text := range texts {
+		translations := providerResults[text]
+		if len(translations) == 0 {
+			remaining = append(remaining, text)
+			continue
+		}
+		providerNames[text] = providerName
+		results[text] = translations
+	}
+	return remaining
+}
+
+func uniqueTexts(texts []string) []string {
+	results := make([]string, 0, len(texts))
+	exists := make(map[string]bool)
+	for _, text := range texts {
+		if exists[text] {
+			continue
+		}
+		exists[text] = true
+		results = append(results, text)
+	}
+	return results
+}
+
 func (u *userUsecase) DictionaryLookupWithPos(ctx context.Context, fromLang, toLang domain.Lang2, text string, pos domain.WordPos) (domain.Translation, error) {
 	results, err := u.DictionaryLookup(ctx, fromLang, toLang, text)
 	if err != nil {
@@ -170,3 +570,215 @@ func (u *userUsecase) DictionaryLookupWithPos(ctx context.Context, fromLang, toL
 	}
 	return nil, service.ErrTranslationNotFound
 }
+
+func (u *userUsecase) DictionaryReverseLookup(ctx context.Context, fromLang, toLang domain.Lang2, translated string, prefix bool) ([]domain.Translation, error) {
+	// the caches are looked up in the forward direction and the providers in the reverse direction
+	if err := u.checkLanguagePair(fromLang, toLang); err != nil {
+		if err := u.checkLanguagePair(toLang, fromLang); err != nil {
+			return nil, err
+		}
+	}
+
+	customRepo := u.rf.NewCustomTranslationRepository(ctx)
+	customResults, err := customRepo.FindByTranslated(ctx, toLang, translated, prefix)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to FindByTranslated in userUsecase.DictionaryReverseLookup. err: %w", err)
+	}
+
+	results := make([]domain.Translation, 0, len(customResults))
+	for _, c := range customResults {
+		if !c.IsDisabled() {
+			results = append(results, c)
+		}
+	}
+	for _, p := range u.chain {
+		if !p.Cached || !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), fromLang, toLang) {
+			continue
+		}
+
+		cacheRepo, err := u.rf.NewTranslationCacheRepository(ctx, p.Name, fromLang)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := cacheRepo.FindByTarget(ctx, toLang, translated, prefix)
+		if err != nil {
+			return nil, liberrors.Errorf("failed to FindByTarget in userUsecase.DictionaryReverseLookup. provider: %s, err: %w", p.Name, err)
+		}
+		for _, e := range entries {
+			for _, a := range e.Results {
+				t, err := a.ToProviderTranslation(p.Name, toLang, e.Text)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, t)
+			}
+		}
+	}
+
+	if len(results) == 0 {
+		results, err = u.providerReverseLookup(ctx, fromLang, toLang, translated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results, err = u.suppressDisabledTranslations(ctx, toLang, results)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.rankReverseTranslations(results), nil
+}
+
+// suppressDisabledTranslations removes the translations whose text and pos have disabled custom translations
+func (u *userUsecase) suppressDisabledTranslations(ctx context.Context, toLang domain.Lang2, translations []domain.Translation) ([]domain.Translation, error) {
+	if len(translations) == 0 {
+		return translations, nil
+	}
+
+	texts := make([]string, 0, len(translations))
+	for _, t := range translations {
+		texts = append(texts, t.GetText())
+	}
+
+	customTranslations, err := u.rf.NewCustomTranslationRepository(ctx).FindByTexts(ctx, toLang, uniqueTexts(texts))
+	if err != nil {
+		return nil, liberrors.Errorf("failed to FindByTexts in userUsecase.suppressDisabledTranslations. err: %w", err)
+	}
+	disabled := make(map[string]map[domain.WordPos]bool)
+	for _, c := range customTranslations {
+		if !c.IsDisabled() {
+			continue
+		}
+		if _, ok := disabled[c.GetText()]; !ok {
+			disabled[c.GetText()] = make(map[domain.WordPos]bool)
+		}
+		disabled[c.GetText()][c.GetPos()] = true
+	}
+
+	results := make([]domain.Translation, 0, len(translations))
+	for _, t := range translations {
+		if !disabled[t.GetText()][t.GetPos()] {
+			results = append(results, t)
+		}
+	}
+	return results, nil
+}
+
+// providerReverseLookup looks up translated in the reverse direction with the first provider which has the translations.
+// The results are not cached because they are not all the translations of the texts.
+func (u *userUsecase) providerReverseLookup(ctx context.Context, fromLang, toLang domain.Lang2, translated string) ([]domain.Translation, error) {
+	logger := log.FromContext(ctx)
+
+	var lastErr error
+	for _, p := range u.chain {
+		if !domain.ContainsLanguagePair(p.Provider.SupportedLanguagePairs(), toLang, fromLang) {
+			continue
+		}
+
+		providerResults, err := p.Provider.DictionaryLookup(ctx, translated, toLang, fromLang)
+		if err != nil {
+			logger.Warnf("failed to DictionaryLookup in the reverse direction. provider: %s, err: %v", p.Name, err)
+			lastErr = err
+			continue
+		}
+		if len(providerResults) == 0 {
+			continue
+		}
+
+		results := make([]domain.Translation, len(providerResults))
+		for i, a := range providerResults {
+			t, err := a.ToReverseTranslation(p.Name, toLang, translated)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = t
+		}
+		return results, nil
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return []domain.Translation{}, nil
+}
+
+// rankReverseTranslations removes the duplicated (text, pos, translated)s and sorts the rest in confidence order. The first one of the duplicated ones is kept.
+func (u *userUsecase) rankReverseTranslations(translations []domain.Translation) []domain.Translation {
+	exists := make(map[string]bool)
+	results := make([]domain.Translation, 0, len(translations))
+	for _, t := range translations {
+		key := t.GetText() + "_" + strconv.Itoa(int(t.GetPos())) + "_" + t.GetTranslated()
+		if exists[key] {
+			continue
+		}
+		exists[key] = true
+		results = append(results, t)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].GetConfidence() > results[j].GetConfidence() })
+	return results
+}
+
+func (u *userUsecase) DictionaryExamples(ctx context.Context, fromLang, toLang domain.Lang2, text, translated string) ([]domain.Example, error) {
+	if u.exampleProvider == nil {
+		return nil, service.ErrExampleProviderNotFound
+	}
+	if !domain.ContainsLanguagePair(u.exampleProvider.SupportedLanguagePairs(), fromLang, toLang) {
+		return nil, liberrors.Errorf("examples are not available in the language pair. pair: %s-%s, err: %w", fromLang.String(), toLang.String(), service.ErrUnsupportedLanguagePair)
+	}
+
+	repo := u.rf.NewAzureExampleRepository(ctx)
+	examples, err := repo.Find(ctx, fromLang, toLang, text, translated)
+	if errors.Is(err, service.ErrExampleNotFound) {
+		examples, err = u.exampleProvider.Examples(ctx, text, translated, fromLang, toLang)
+		if err != nil {
+			return nil, liberrors.Errorf("failed to Examples in userUsecase.DictionaryExamples. text: %s, err: %w", text, err)
+		}
+
+		// the same pair may have been cached by another request in the meantime
+		if err := repo.Add(ctx, fromLang, toLang, text, translated, examples); err != nil && !errors.Is(err, service.ErrAzureExampleAlreadyExists) {
+			return nil, liberrors.Errorf("failed to Add in userUsecase.DictionaryExamples. text: %s, err: %w", text, err)
+		}
+	} else if err != nil {
+		return nil, liberrors.Errorf("failed to Find in userUsecase.DictionaryExamples. text: %s, err: %w", text, err)
+	}
+
+	results := make([]domain.Example, len(examples))
+	for i, e := range examples {
+		example, err := e.ToExample(toLang, text, translated)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = example
+	}
+	return results, nil
+}
+
+func (u *userUsecase) Translate(ctx context.Context, fromLang, toLang domain.Lang2, text string) (domain.TextTranslation, error) {
+	if u.textTranslator == nil {
+		return nil, service.ErrTextTranslatorNotFound
+	}
+	// the pair cannot be checked until the language is detected
+	if fromLang != nil && !domain.ContainsLanguagePair(u.textTranslator.SupportedTextLanguagePairs(), fromLang, toLang) {
+		return nil, liberrors.Errorf("the text translator does not support the language pair. pair: %s-%s, err: %w", fromLang.String(), toLang.String(), service.ErrUnsupportedLanguagePair)
+	}
+
+	repo := u.rf.NewAzureTextTranslationRepository(ctx)
+	result, err := repo.Find(ctx, fromLang, toLang, text)
+	if errors.Is(err, service.ErrTextTranslationNotFound) {
+		result, err = u.textTranslator.Translate(ctx, text, fromLang, toLang)
+		if err != nil {
+			return nil, liberrors.Errorf("failed to Translate in userUsecase.Translate. err: %w", err)
+		}
+
+		// the same text may have been cached by another request in the meantime
+		if err := repo.Add(ctx, fromLang, toLang, text, result); err != nil && !errors.Is(err, service.ErrAzureTextTranslationAlreadyExists) {
+			return nil, liberrors.Errorf("failed to Add in userUsecase.Translate. err: %w", err)
+		}
+	} else if err != nil {
+		return nil, liberrors.Errorf("failed to Find in userUsecase.Translate. err: %w", err)
+	}
+
+	return result.ToTextTranslation(toLang, text)
+}
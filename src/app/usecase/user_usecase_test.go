@@ -2,10 +2,14 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/service"
@@ -18,10 +22,13 @@ func test_userUsecase_DictionaryLookup_init(t *testing.T, ctx context.Context) (
 	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
 	customTranslationRepo := new(service_mock.CustomTranslationRepository)
 	rf := new(service_mock.RepositoryFactory)
-	rf.On("NewAzureTranslationRepository", ctx).Return(azureTranslationRepo, nil)
-	rf.On("NewCustomTranslationRepository", ctx).Return(customTranslationRepo, nil)
+	rf.On("NewTranslationCacheRepository", anythingOfContext, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	rf.On("NewCustomTranslationRepository", anythingOfContext).Return(customTranslationRepo, nil)
 	azureTranslationClient := new(service_mock.AzureTranslationClient)
-	userUsecase := usecase.NewUserUsecase(rf, azureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	}, azureTranslationClient, azureTranslationClient)
 
 	return azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase
 }
@@ -32,19 +39,19 @@ func Test_userUsecase_DictionaryLookup_azureRepo(t *testing.T) {
 
 	// given
 	// - azureRepo has two data
-	azureRepoResults := []service.AzureTranslation{
+	azureRepoResults := []service.TranslationCandidate{
 		{
 			Pos:        domain.PosNoun,
 			Target:     "本ar",
 			Confidence: 1,
 		},
 	}
-	azureTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
-	azureTranslationRepo.On("Find", bg, domain.Lang2JA, "book").Return(azureRepoResults, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "book").Return(azureRepoResults, nil)
 	// - azureClient has no data
-	azureClientResults := []service.AzureTranslation{}
-	azureTranslationClient.On("DictionaryLookup", bg, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
-	azureTranslationRepo.On("Add", bg, domain.Lang2JA, "book", azureClientResults).Return(nil)
+	azureClientResults := []service.TranslationCandidate{}
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(nil)
 	// - customRepo has no data
 	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
 
@@ -62,15 +69,15 @@ func Test_userUsecase_DictionaryLookup_azureClient(t *testing.T) {
 
 	// given
 	// - azureRepo has no data
-	azureTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
 	// - azureClient has one data
-	azureClientResults := []service.AzureTranslation{{
+	azureClientResults := []service.TranslationCandidate{{
 		Pos:        domain.PosNoun,
 		Target:     "本ar",
 		Confidence: 1,
 	}}
-	azureTranslationClient.On("DictionaryLookup", bg, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
-	azureTranslationRepo.On("Add", bg, domain.Lang2JA, "book", azureClientResults).Return(nil)
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(nil)
 	// - customRepo has no data
 	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
 
@@ -89,21 +96,21 @@ func Test_userUsecase_DictionaryLookup_azureRepo_azureClient(t *testing.T) {
 
 	// given
 	// - azureRepo has one data
-	azureRepoResults := []service.AzureTranslation{{
+	azureRepoResults := []service.TranslationCandidate{{
 		Pos:        domain.PosNoun,
 		Target:     "本ar",
 		Confidence: 1,
 	}}
-	azureTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
-	azureTranslationRepo.On("Find", bg, domain.Lang2JA, "book").Return(azureRepoResults, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "book").Return(azureRepoResults, nil)
 	// - azureClient has  onedata
-	azureClientResults := []service.AzureTranslation{{
+	azureClientResults := []service.TranslationCandidate{{
 		Pos:        domain.PosNoun,
 		Target:     "本ac",
 		Confidence: 1,
 	}}
-	azureTranslationClient.On("DictionaryLookup", bg, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
-	azureTranslationRepo.On("Add", bg, domain.Lang2JA, "book", azureClientResults).Return(nil)
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(nil)
 	// - customRepo has no data
 	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
 
@@ -129,7 +136,7 @@ func Test_userUsecase_DictionaryLookup_custom_azureRepo(t *testing.T) {
 	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
 	customTranslationRepo.On("FindByText", bg, domain.Lang2JA, "book").Return(customRepoResults, nil)
 	// - azureRepo has two data. One is a noun word and the other is a verb word.
-	azureRepoResults := []service.AzureTranslation{
+	azureRepoResults := []service.TranslationCandidate{
 		{
 			Pos:        domain.PosNoun,
 			Target:     "本ar",
@@ -141,8 +148,8 @@ func Test_userUsecase_DictionaryLookup_custom_azureRepo(t *testing.T) {
 			Confidence: 1,
 		},
 	}
-	azureTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
-	azureTranslationRepo.On("Find", bg, domain.Lang2JA, "book").Return(azureRepoResults, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "book").Return(azureRepoResults, nil)
 	// - azureClient has no data
 	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
 
@@ -157,3 +164,751 @@ func Test_userUsecase_DictionaryLookup_custom_azureRepo(t *testing.T) {
 	assert.Equal(t, actual[0].GetTranslated(), "本c")
 	assert.Equal(t, actual[1].GetTranslated(), "予約するar")
 }
+
+func Test_userUsecase_DictionaryLookup_disabled(t *testing.T) {
+	bg := context.Background()
+	_, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - the modal verb of "can" is disabled
+	canVerb, err := domain.NewDisabledTranslation(2, time.Now(), time.Now(), "can", domain.PosVerb, domain.Lang2JA, "", service.TranslationProviderCustom, domain.TranslationDetail{})
+	require.NoError(t, err)
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "can").Return(true, nil)
+	customTranslationRepo.On("FindByText", bg, domain.Lang2JA, "can").Return([]domain.Translation{canVerb}, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "can").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "can").Return([]service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "缶", Confidence: 0.6},
+		{Pos: domain.PosVerb, Target: "できる", Confidence: 0.9},
+	}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "can")
+	require.NoError(t, err)
+
+	// then
+	// - the verb of azure is suppressed
+	require.Equal(t, 1, len(actual))
+	assert.Equal(t, "缶", actual[0].GetTranslated())
+
+	// when
+	candidates, err := userUsecase.DictionaryLookupCandidates(bg, domain.Lang2EN, domain.Lang2JA, "can")
+	require.NoError(t, err)
+
+	// then
+	// - the pos has no candidates
+	require.Equal(t, 1, len(candidates))
+	assert.Equal(t, domain.PosNoun, candidates[0].GetPos())
+}
+
+func Test_userUsecase_DictionaryLookup_fallback(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - the chain is azure(cached) -> offline
+	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
+	customTranslationRepo := new(service_mock.CustomTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", anythingOfContext, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	rf.On("NewCustomTranslationRepository", bg).Return(customTranslationRepo, nil)
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	offlineProvider := new(service_mock.TranslationProvider)
+	offlineProvider.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+		{Name: "offline", Provider: offlineProvider, Cached: false},
+	}, nil, nil)
+	// - customRepo and azureRepo have no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
+	// - azureClient fails
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return(nil, errors.New("service unavailable"))
+	// - offlineProvider has one data
+	offlineProvider.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return([]service.TranslationCandidate{{
+		Pos:        domain.PosNoun,
+		Target:     "本o",
+		Confidence: 1,
+	}}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "book")
+	assert.NoError(t, err)
+
+	// then
+	// - the translation of offlineProvider is selected and it is not cached
+	assert.Equal(t, 1, len(actual))
+	assert.Equal(t, "本o", actual[0].GetTranslated())
+	assert.Equal(t, "offline", actual[0].GetProvider())
+	azureTranslationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_userUsecase_DictionaryLookup_providerLanguagePairs(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - the chain is azure(cached, en-ja) -> offline(ja-en)
+	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
+	customTranslationRepo := new(service_mock.CustomTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", anythingOfContext, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	rf.On("NewCustomTranslationRepository", bg).Return(customTranslationRepo, nil)
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	offlineProvider := new(service_mock.TranslationProvider)
+	offlineProvider.On("SupportedLanguagePairs").Return([]domain.LanguagePair{{FromLang: domain.Lang2JA, ToLang: domain.Lang2EN}})
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+		{Name: "offline", Provider: offlineProvider, Cached: false},
+	}, nil, nil)
+	// - customRepo has no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2EN, "本").Return(false, nil)
+	customTranslationRepo.On("FindByTexts", bg, domain.Lang2EN, []string{"本"}).Return([]domain.Translation{}, nil)
+	// - offlineProvider has one data
+	bookResults := []service.TranslationCandidate{{
+		Pos:        domain.PosNoun,
+		Target:     "booko",
+		Confidence: 1,
+	}}
+	offlineProvider.On("DictionaryLookup", anythingOfContext, "本", domain.Lang2JA, domain.Lang2EN).Return(bookResults, nil)
+	offlineProvider.On("DictionaryLookupBatch", bg, []string{"本"}, domain.Lang2JA, domain.Lang2EN).Return(map[string][]service.TranslationCandidate{
+		"本": bookResults,
+	}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2JA, domain.Lang2EN, "本")
+	assert.NoError(t, err)
+
+	// then
+	// - the translation of offlineProvider is selected
+	assert.Equal(t, 1, len(actual))
+	assert.Equal(t, "booko", actual[0].GetTranslated())
+	assert.Equal(t, "offline", actual[0].GetProvider())
+
+	// when
+	actualBatch, err := userUsecase.DictionaryLookupBatch(bg, domain.Lang2JA, domain.Lang2EN, []string{"本"})
+	assert.NoError(t, err)
+
+	// then
+	assert.Equal(t, 1, len(actualBatch["本"]))
+	assert.Equal(t, "booko", actualBatch["本"][0].GetTranslated())
+
+	// - neither the cache nor the client of azure is used for ja-en
+	azureTranslationRepo.AssertNotCalled(t, "Contain", mock.Anything, mock.Anything, mock.Anything)
+	azureTranslationRepo.AssertNotCalled(t, "FindByTexts", mock.Anything, mock.Anything, mock.Anything)
+	azureTranslationRepo.AssertNotCalled(t, "AddNegative", mock.Anything, mock.Anything, mock.Anything)
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookupBatch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_userUsecase_DictionaryLookup_unsupportedLanguagePair(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, _, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+	fr, err := domain.NewLang2("fr")
+	require.NoError(t, err)
+
+	// given
+	// - azure supports only en-ja and ja-en
+
+	// when
+	_, err = userUsecase.DictionaryLookup(bg, domain.Lang2EN, fr, "book")
+	// then
+	assert.ErrorIs(t, err, service.ErrUnsupportedLanguagePair)
+
+	// when
+	_, err = userUsecase.DictionaryLookupBatch(bg, fr, domain.Lang2EN, []string{"livre"})
+	// then
+	assert.ErrorIs(t, err, service.ErrUnsupportedLanguagePair)
+
+	// - neither the repositories nor the providers are called
+	customTranslationRepo.AssertNotCalled(t, "Contain", bg, fr, "book")
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, fr)
+}
+
+func Test_userUsecase_DictionaryLookupBatch(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	texts := []string{"book", "pen", "run", "book", "xyz"}
+	uniqueTexts := []string{"book", "pen", "run", "xyz"}
+	// - customRepo has "book"
+	bookNoun, err := domain.NewTranslation(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本c", service.TranslationProviderCustom)
+	assert.NoError(t, err)
+	customTranslationRepo.On("FindByTexts", bg, domain.Lang2JA, uniqueTexts).Return([]domain.Translation{bookNoun}, nil)
+	// - azureRepo has "book" and "pen"
+	azureTranslationRepo.On("FindByTexts", bg, domain.Lang2JA, uniqueTexts).Return(map[string][]service.TranslationCandidate{
+		"book": {{Pos: domain.PosVerb, Target: "予約するar", Confidence: 1}},
+		"pen":  {{Pos: domain.PosNoun, Target: "ペンar", Confidence: 1}},
+	}, nil)
+	// - azureClient is called only with the misses and has "run"
+	runResults := []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "走るac", Confidence: 1}}
+	azureTranslationClient.On("DictionaryLookupBatch", bg, []string{"run", "xyz"}, domain.Lang2EN, domain.Lang2JA).Return(map[string][]service.TranslationCandidate{
+		"run": runResults,
+		"xyz": {},
+	}, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "run", runResults).Return(nil)
+	azureTranslationRepo.On("AddNegative", anythingOfContext, domain.Lang2JA, "xyz").Return(nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookupBatch(bg, domain.Lang2EN, domain.Lang2JA, texts)
+	assert.NoError(t, err)
+
+	// then
+	assert.Equal(t, 4, len(actual))
+	assert.Equal(t, 2, len(actual["book"]))
+	assert.Equal(t, "本c", actual["book"][0].GetTranslated())
+	assert.Equal(t, "予約するar", actual["book"][1].GetTranslated())
+	assert.Equal(t, "ペンar", actual["pen"][0].GetTranslated())
+	assert.Equal(t, "走るac", actual["run"][0].GetTranslated())
+	assert.Equal(t, service.TranslationProviderAzure, actual["run"][0].GetProvider())
+	assert.Equal(t, 0, len(actual["xyz"]))
+	azureTranslationRepo.AssertNumberOfCalls(t, "Add", 1)
+	azureTranslationRepo.AssertNumberOfCalls(t, "AddNegative", 1)
+	azureTranslationClient.AssertNumberOfCalls(t, "DictionaryLookupBatch", 1)
+}
+
+func Test_userUsecase_DictionaryLookup_addNegative(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo and azureRepo have no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "bokk").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "bokk").Return(false, nil)
+	// - azureClient has no data
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "bokk", domain.Lang2EN, domain.Lang2JA).Return([]service.TranslationCandidate{}, nil)
+	azureTranslationRepo.On("AddNegative", anythingOfContext, domain.Lang2JA, "bokk").Return(nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "bokk")
+	assert.NoError(t, err)
+
+	// then
+	// - "no result" is cached
+	assert.Equal(t, 0, len(actual))
+	azureTranslationRepo.AssertCalled(t, "AddNegative", anythingOfContext, domain.Lang2JA, "bokk")
+}
+
+func Test_userUsecase_DictionaryLookup_negativeCached(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo has no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "bokk").Return(false, nil)
+	// - azureRepo has a negative entry
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "bokk").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "bokk").Return([]service.TranslationCandidate{}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "bokk")
+	assert.NoError(t, err)
+
+	// then
+	// - azureClient is not called
+	assert.Equal(t, 0, len(actual))
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_userUsecase_DictionaryLookup_coalesced(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo and azureRepo have no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
+	// - azureClient is slow
+	azureClientResults := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 1}}
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).After(100*time.Millisecond).Return(azureClientResults, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(nil)
+
+	// when
+	// - the same text is looked up concurrently
+	const n = 10
+	actuals := make([][]domain.Translation, n)
+	errs := make([]error, n)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			actuals[i], errs[i] = userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "book")
+		}(i)
+	}
+	wg.Wait()
+
+	// then
+	// - azureClient is called only once
+	for i := 0; i < n; i++ {
+		assert.NoError(t, errs[i])
+		assert.Equal(t, 1, len(actuals[i]))
+		assert.Equal(t, "本", actuals[i][0].GetTranslated())
+	}
+	azureTranslationClient.AssertNumberOfCalls(t, "DictionaryLookup", 1)
+	azureTranslationRepo.AssertNumberOfCalls(t, "Add", 1)
+}
+
+type testContextKey struct{}
+
+func Test_userUsecase_DictionaryLookup_coalescedCallerCanceled(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo and azureRepo have no data
+	customTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
+	// - azureClient is slow and records the state of its context when it returns
+	var lookupErr error
+	var lookupValue interface{}
+	azureClientResults := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 1}}
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).After(200*time.Millisecond).Run(func(args mock.Arguments) {
+		ctx := args.Get(0).(context.Context)
+		lookupErr = ctx.Err()
+		lookupValue = ctx.Value(testContextKey{})
+	}).Return(azureClientResults, nil)
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(nil)
+
+	// when
+	// - the first caller leaves while the second caller is waiting for the same lookup
+	firstCtx, cancel := context.WithCancel(context.WithValue(bg, testContextKey{}, "first"))
+	defer cancel()
+	var firstErr, secondErr error
+	var secondActual []domain.Translation
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, firstErr = userUsecase.DictionaryLookup(firstCtx, domain.Lang2EN, domain.Lang2JA, "book")
+	}()
+	time.Sleep(50 * time.Millisecond)
+	go func() {
+		defer wg.Done()
+		secondActual, secondErr = userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "book")
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	// then
+	// - the first caller gets its own error
+	// - the lookup is not canceled, keeps the values of the first caller and its result is shared with the second caller
+	assert.ErrorIs(t, firstErr, context.Canceled)
+	assert.NoError(t, secondErr)
+	require.Equal(t, 1, len(secondActual))
+	assert.Equal(t, "本", secondActual[0].GetTranslated())
+	assert.NoError(t, lookupErr)
+	assert.Equal(t, "first", lookupValue)
+	azureTranslationClient.AssertNumberOfCalls(t, "DictionaryLookup", 1)
+}
+
+func Test_userUsecase_DictionaryLookup_alreadyCached(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo and azureRepo have no data
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(false, nil)
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(false, nil)
+	azureClientResults := []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "本", Confidence: 1}}
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "book", domain.Lang2EN, domain.Lang2JA).Return(azureClientResults, nil)
+	// - another request has added the cache
+	azureTranslationRepo.On("Add", anythingOfContext, domain.Lang2JA, "book", azureClientResults).Return(service.ErrAzureTranslationAlreadyExists)
+
+	// when
+	actual, err := userUsecase.DictionaryLookup(bg, domain.Lang2EN, domain.Lang2JA, "book")
+
+	// then
+	// - the duplicate insert is not an error
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(actual))
+	assert.Equal(t, "本", actual[0].GetTranslated())
+}
+
+func Test_userUsecase_DictionaryLookupCandidates(t *testing.T) {
+	bg := context.Background()
+	_, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - customRepo has one noun
+	bookNoun, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "book", domain.PosNoun, domain.Lang2JA, "本c", "", domain.TranslationDetail{Confidence: 1})
+	assert.NoError(t, err)
+	customTranslationRepo.On("Contain", bg, domain.Lang2JA, "book").Return(true, nil)
+	customTranslationRepo.On("FindByText", bg, domain.Lang2JA, "book").Return([]domain.Translation{bookNoun}, nil)
+	// - azureRepo has three nouns and one verb
+	azureRepoResults := []service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "帳簿", Confidence: 0.2},
+		{Pos: domain.PosNoun, Target: "本c", Confidence: 0.3},
+		{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.9},
+		{Pos: domain.PosNoun, Target: "書籍", Confidence: 0.5},
+	}
+	azureTranslationRepo.On("Contain", anythingOfContext, domain.Lang2JA, "book").Return(true, nil)
+	azureTranslationRepo.On("Find", anythingOfContext, domain.Lang2JA, "book").Return(azureRepoResults, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryLookupCandidates(bg, domain.Lang2EN, domain.Lang2JA, "book")
+	assert.NoError(t, err)
+
+	// then
+	// - the custom translation takes the first rank and the duplicated provider translation is removed
+	// - the provider translations are ranked in confidence order for each pos
+	type candidate struct {
+		pos        domain.WordPos
+		translated string
+		rank       int
+		confidence float64
+	}
+	expected := []candidate{
+		{pos: domain.PosNoun, translated: "本c", rank: 1, confidence: 1},
+		{pos: domain.PosNoun, translated: "書籍", rank: 2, confidence: 0.5},
+		{pos: domain.PosNoun, translated: "帳簿", rank: 3, confidence: 0.2},
+		{pos: domain.PosVerb, translated: "予約する", rank: 1, confidence: 0.9},
+	}
+	assert.Equal(t, len(expected), len(actual))
+	for i, e := range expected {
+		assert.Equal(t, e, candidate{
+			pos:        actual[i].GetPos(),
+			translated: actual[i].GetTranslated(),
+			rank:       actual[i].GetRank(),
+			confidence: actual[i].GetConfidence(),
+		})
+	}
+}
+
+func Test_userUsecase_DictionaryReverseLookup(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - "reserve" is a custom translation
+	reserveVerb, err := domain.NewTranslationWithDetail(1, time.Now(), time.Now(), "reserve", domain.PosVerb, domain.Lang2JA, "予約する", service.TranslationProviderCustom, domain.TranslationDetail{Confidence: 1})
+	require.NoError(t, err)
+	customTranslationRepo.On("FindByTranslated", bg, domain.Lang2JA, "予約", true).Return([]domain.Translation{reserveVerb}, nil)
+	// - "book" and "reserve" are cached
+	azureTranslationRepo.On("FindByTarget", bg, domain.Lang2JA, "予約", true).Return([]service.TranslationCacheEntry{
+		{Lang2: domain.Lang2JA, Text: "book", Results: []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.1}}},
+		{Lang2: domain.Lang2JA, Text: "reservation", Results: []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "予約", Confidence: 0.7}}},
+		{Lang2: domain.Lang2JA, Text: "reserve", Results: []service.TranslationCandidate{{Pos: domain.PosVerb, Target: "予約する", Confidence: 0.5}}},
+		{Lang2: domain.Lang2JA, Text: "booking", Results: []service.TranslationCandidate{{Pos: domain.PosNoun, Target: "予約", Confidence: 0.3}}},
+	}, nil)
+	// - "booking"(noun) is disabled
+	bookingNoun, err := domain.NewDisabledTranslation(2, time.Now(), time.Now(), "booking", domain.PosNoun, domain.Lang2JA, "", service.TranslationProviderCustom, domain.TranslationDetail{})
+	require.NoError(t, err)
+	customTranslationRepo.On("FindByTexts", bg, domain.Lang2JA, mock.Anything).Return([]domain.Translation{reserveVerb, bookingNoun}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryReverseLookup(bg, domain.Lang2EN, domain.Lang2JA, "予約", true)
+
+	// then
+	// - the translations are in confidence order and the custom translation takes the place of the cached one
+	// - the disabled translation is suppressed
+	require.NoError(t, err)
+	texts := make([]string, len(actual))
+	for i, a := range actual {
+		texts[i] = a.GetText()
+	}
+	assert.Equal(t, []string{"reserve", "reservation", "book"}, texts)
+	assert.Equal(t, service.TranslationProviderCustom, actual[0].GetProvider())
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", anythingOfContext, "予約", domain.Lang2JA, domain.Lang2EN)
+}
+
+func Test_userUsecase_DictionaryReverseLookup_provider(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient, azureTranslationRepo, customTranslationRepo, userUsecase := test_userUsecase_DictionaryLookup_init(t, bg)
+
+	// given
+	// - nothing is cached
+	customTranslationRepo.On("FindByTranslated", bg, domain.Lang2JA, "予約", false).Return([]domain.Translation{}, nil)
+	customTranslationRepo.On("FindByTexts", bg, domain.Lang2JA, mock.Anything).Return([]domain.Translation{}, nil)
+	azureTranslationRepo.On("FindByTarget", bg, domain.Lang2JA, "予約", false).Return([]service.TranslationCacheEntry{}, nil)
+	// - azure has the translations in the reverse direction
+	azureTranslationClient.On("DictionaryLookup", anythingOfContext, "予約", domain.Lang2JA, domain.Lang2EN).Return([]service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "booking", Confidence: 0.2},
+		{Pos: domain.PosNoun, Target: "reservation", Confidence: 0.8},
+	}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryReverseLookup(bg, domain.Lang2EN, domain.Lang2JA, "予約", false)
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, 2, len(actual))
+	assert.Equal(t, "reservation", actual[0].GetText())
+	assert.Equal(t, "予約", actual[0].GetTranslated())
+	assert.Equal(t, domain.Lang2JA, actual[0].GetLang2())
+	assert.Equal(t, "booking", actual[1].GetText())
+	// - the results are not cached
+	azureTranslationRepo.AssertNotCalled(t, "Add", anythingOfContext, domain.Lang2JA, "予約", mock.Anything)
+}
+
+func Test_userUsecase_DictionaryReverseLookup_oneDirection(t *testing.T) {
+	bg := context.Background()
+
+	// given
+	// - the chain is azure(cached, en-ja) -> offline(cached, ja-en)
+	azureTranslationRepo := new(service_mock.AzureTranslationRepository)
+	customTranslationRepo := new(service_mock.CustomTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewTranslationCacheRepository", anythingOfContext, service.TranslationProviderAzure, domain.Lang2EN).Return(azureTranslationRepo, nil)
+	rf.On("NewCustomTranslationRepository", bg).Return(customTranslationRepo, nil)
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	offlineProvider := new(service_mock.TranslationProvider)
+	offlineProvider.On("SupportedLanguagePairs").Return([]domain.LanguagePair{{FromLang: domain.Lang2JA, ToLang: domain.Lang2EN}})
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+		{Name: "offline", Provider: offlineProvider, Cached: true},
+	}, nil, nil)
+	// - nothing is cached
+	customTranslationRepo.On("FindByTranslated", bg, domain.Lang2JA, "予約", false).Return([]domain.Translation{}, nil)
+	customTranslationRepo.On("FindByTexts", bg, domain.Lang2JA, mock.Anything).Return([]domain.Translation{}, nil)
+	azureTranslationRepo.On("FindByTarget", bg, domain.Lang2JA, "予約", false).Return([]service.TranslationCacheEntry{}, nil)
+	// - offlineProvider has the translation in the reverse direction
+	offlineProvider.On("DictionaryLookup", anythingOfContext, "予約", domain.Lang2JA, domain.Lang2EN).Return([]service.TranslationCandidate{
+		{Pos: domain.PosNoun, Target: "reservation", Confidence: 1},
+	}, nil)
+
+	// when
+	actual, err := userUsecase.DictionaryReverseLookup(bg, domain.Lang2EN, domain.Lang2JA, "予約", false)
+
+	// then
+	// - the cache of azure is looked up because azure supports en-ja
+	// - azure is not looked up in the reverse direction because it does not support ja-en
+	// - the cache of offline is not looked up because offline does not support en-ja
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actual))
+	assert.Equal(t, "reservation", actual[0].GetText())
+	assert.Equal(t, "offline", actual[0].GetProvider())
+	azureTranslationRepo.AssertCalled(t, "FindByTarget", bg, domain.Lang2JA, "予約", false)
+	azureTranslationClient.AssertNotCalled(t, "DictionaryLookup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	rf.AssertNotCalled(t, "NewTranslationCacheRepository", mock.Anything, "offline", mock.Anything)
+
+	// when
+	lang2FR, err := domain.NewLang2("fr")
+	require.NoError(t, err)
+	_, err = userUsecase.DictionaryReverseLookup(bg, domain.Lang2EN, lang2FR, "livre", false)
+
+	// then
+	// - no provider supports en-fr in either direction
+	assert.ErrorIs(t, err, service.ErrUnsupportedLanguagePair)
+}
+
+func Test_userUsecase_DictionaryExamples(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureExampleRepo := new(service_mock.AzureExampleRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewAzureExampleRepository", bg).Return(azureExampleRepo)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{}, azureTranslationClient, azureTranslationClient)
+
+	// given
+	// - "book" and "本" are cached
+	bookExamples := []service.AzureExample{{
+		Source: domain.ExampleSentence{Prefix: "I read a ", Term: "book", Suffix: "."},
+		Target: domain.ExampleSentence{Prefix: "私は", Term: "本", Suffix: "を読んだ。"},
+	}}
+	azureExampleRepo.On("Find", bg, domain.Lang2EN, domain.Lang2JA, "book", "本").Return(bookExamples, nil)
+	// - "run" and "走る" are not cached
+	runExamples := []service.AzureExample{{
+		Source: domain.ExampleSentence{Prefix: "I ", Term: "run", Suffix: " every day."},
+		Target: domain.ExampleSentence{Prefix: "私は毎日", Term: "走る", Suffix: "。"},
+	}}
+	azureExampleRepo.On("Find", bg, domain.Lang2EN, domain.Lang2JA, "run", "走る").Return(nil, service.ErrExampleNotFound)
+	azureTranslationClient.On("Examples", bg, "run", "走る", domain.Lang2EN, domain.Lang2JA).Return(runExamples, nil)
+	azureExampleRepo.On("Add", bg, domain.Lang2EN, domain.Lang2JA, "run", "走る", runExamples).Return(nil)
+
+	// when
+	actual, err := userUsecase.DictionaryExamples(bg, domain.Lang2EN, domain.Lang2JA, "book", "本")
+	// then
+	// - the cached examples are returned without looking up azure
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actual))
+	assert.Equal(t, "I read a book.", actual[0].GetSource().String())
+	azureTranslationClient.AssertNotCalled(t, "Examples", bg, "book", "本", domain.Lang2EN, domain.Lang2JA)
+
+	// when
+	actual, err = userUsecase.DictionaryExamples(bg, domain.Lang2EN, domain.Lang2JA, "run", "走る")
+	// then
+	// - the examples of azure are cached
+	require.NoError(t, err)
+	require.Equal(t, 1, len(actual))
+	assert.Equal(t, "私は毎日走る。", actual[0].GetTarget().String())
+	azureExampleRepo.AssertCalled(t, "Add", bg, domain.Lang2EN, domain.Lang2JA, "run", "走る", runExamples)
+}
+
+func Test_userUsecase_DictionaryExamples_noProvider(t *testing.T) {
+	bg := context.Background()
+	rf := new(service_mock.RepositoryFactory)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{}, nil, nil)
+
+	// when
+	_, err := userUsecase.DictionaryExamples(bg, domain.Lang2EN, domain.Lang2JA, "book", "本")
+
+	// then
+	assert.ErrorIs(t, err, service.ErrExampleProviderNotFound)
+}
+
+func Test_userUsecase_DictionaryExamples_unsupportedLanguagePair(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	rf := new(service_mock.RepositoryFactory)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{}, azureTranslationClient, azureTranslationClient)
+
+	// when
+	_, err := userUsecase.DictionaryExamples(bg, domain.Lang2JA, domain.Lang2EN, "本", "book")
+
+	// then
+	assert.ErrorIs(t, err, service.ErrUnsupportedLanguagePair)
+	azureTranslationClient.AssertNotCalled(t, "Examples", bg, "本", "book", domain.Lang2JA, domain.Lang2EN)
+}
+
+func Test_userUsecase_Translate(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTextTranslationRepo := new(service_mock.AzureTextTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewAzureTextTranslationRepository", bg).Return(azureTextTranslationRepo)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	}, azureTranslationClient, azureTranslationClient)
+
+	// given
+	// - the text is not cached
+	text := "I read a book."
+	translated := &service.AzureTextTranslation{
+		FromLang:          domain.Lang2EN,
+		Translated:        "私は本を読んだ。",
+		Detected:          true,
+		DetectedLangScore: 0.98,
+	}
+	azureTextTranslationRepo.On("Find", bg, nil, domain.Lang2JA, text).Return(nil, service.ErrTextTranslationNotFound)
+	azureTranslationClient.On("Translate", bg, text, nil, domain.Lang2JA).Return(translated, nil)
+	azureTextTranslationRepo.On("Add", bg, nil, domain.Lang2JA, text, translated).Return(nil)
+
+	// when
+	actual, err := userUsecase.Translate(bg, nil, domain.Lang2JA, text)
+
+	// then
+	// - the detected language is returned and the translation is cached
+	require.NoError(t, err)
+	assert.Equal(t, "私は本を読んだ。", actual.GetTranslated())
+	assert.Equal(t, domain.Lang2EN, actual.GetFromLang())
+	assert.True(t, actual.IsDetected())
+	assert.Equal(t, 0.98, actual.GetDetectedLangScore())
+	azureTextTranslationRepo.AssertCalled(t, "Add", bg, nil, domain.Lang2JA, text, translated)
+}
+
+func Test_userUsecase_Translate_cached(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTextTranslationRepo := new(service_mock.AzureTextTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewAzureTextTranslationRepository", bg).Return(azureTextTranslationRepo)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	azureTranslationClient.On("SupportedTextLanguagePairs").Return(enToJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	}, azureTranslationClient, azureTranslationClient)
+
+	// given
+	text := "I read a book."
+	azureTextTranslationRepo.On("Find", bg, domain.Lang2EN, domain.Lang2JA, text).Return(&service.AzureTextTranslation{
+		FromLang:   domain.Lang2EN,
+		Translated: "私は本を読んだ。",
+	}, nil)
+
+	// when
+	actual, err := userUsecase.Translate(bg, domain.Lang2EN, domain.Lang2JA, text)
+
+	// then
+	// - azure is not called
+	require.NoError(t, err)
+	assert.Equal(t, "私は本を読んだ。", actual.GetTranslated())
+	assert.False(t, actual.IsDetected())
+	azureTranslationClient.AssertNotCalled(t, "Translate", bg, text, domain.Lang2EN, domain.Lang2JA)
+}
+
+func Test_userUsecase_Translate_unknownDetectedLanguage(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTextTranslationRepo := new(service_mock.AzureTextTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewAzureTextTranslationRepository", bg).Return(azureTextTranslationRepo)
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	}, azureTranslationClient, azureTranslationClient)
+
+	// given
+	// - the detected language has no ISO 639-1 code
+	text := "Aloha kakahiaka."
+	translated := &service.AzureTextTranslation{
+		FromLang:          domain.Lang2Unknown,
+		Translated:        "おはよう。",
+		Detected:          true,
+		DetectedLangScore: 0.8,
+	}
+	azureTextTranslationRepo.On("Find", bg, nil, domain.Lang2JA, text).Return(nil, service.ErrTextTranslationNotFound)
+	azureTranslationClient.On("Translate", bg, text, nil, domain.Lang2JA).Return(translated, nil)
+	azureTextTranslationRepo.On("Add", bg, nil, domain.Lang2JA, text, translated).Return(nil)
+
+	// when
+	actual, err := userUsecase.Translate(bg, nil, domain.Lang2JA, text)
+
+	// then
+	// - the text is translated and the language is unknown
+	require.NoError(t, err)
+	assert.Equal(t, "おはよう。", actual.GetTranslated())
+	assert.Equal(t, "", actual.GetFromLang().String())
+	assert.True(t, actual.IsDetected())
+}
+
+func Test_userUsecase_Translate_textLanguagePairs(t *testing.T) {
+	bg := context.Background()
+	azureTranslationClient := new(service_mock.AzureTranslationClient)
+	azureTextTranslationRepo := new(service_mock.AzureTextTranslationRepository)
+	rf := new(service_mock.RepositoryFactory)
+	rf.On("NewAzureTextTranslationRepository", bg).Return(azureTextTranslationRepo)
+	// - the dictionary supports only en-ja while texts can be translated from ja into en
+	azureTranslationClient.On("SupportedLanguagePairs").Return(enToJaLanguagePairs)
+	azureTranslationClient.On("SupportedTextLanguagePairs").Return([]domain.LanguagePair{
+		{FromLang: domain.Lang2EN, ToLang: domain.Lang2JA},
+		{FromLang: domain.Lang2JA, ToLang: domain.Lang2EN},
+	})
+	userUsecase := usecase.NewUserUsecase(rf, []service.TranslationProviderChainItem{
+		{Name: service.TranslationProviderAzure, Provider: azureTranslationClient, Cached: true},
+	}, azureTranslationClient, azureTranslationClient)
+
+	// given
+	text := "本を読んだ。"
+	translated := &service.AzureTextTranslation{
+		FromLang:   domain.Lang2JA,
+		Translated: "I read a book.",
+	}
+	azureTextTranslationRepo.On("Find", bg, domain.Lang2JA, domain.Lang2EN, text).Return(nil, service.ErrTextTranslationNotFound)
+	azureTranslationClient.On("Translate", bg, text, domain.Lang2JA, domain.Lang2EN).Return(translated, nil)
+	azureTextTranslationRepo.On("Add", bg, domain.Lang2JA, domain.Lang2EN, text, translated).Return(nil)
+
+	// when
+	actual, err := userUsecase.Translate(bg, domain.Lang2JA, domain.Lang2EN, text)
+
+	// then
+	// - the pair is checked against the text translator, not the dictionary
+	require.NoError(t, err)
+	assert.Equal(t, "I read a book.", actual.GetTranslated())
+
+	// when
+	lang2FR, err := domain.NewLang2("fr")
+	require.NoError(t, err)
+	_, err = userUsecase.Translate(bg, domain.Lang2JA, lang2FR, text)
+
+	// then
+	// - neither the cache nor azure is called for the pair which the text translator does not support
+	assert.ErrorIs(t, err, service.ErrUnsupportedLanguagePair)
+	azureTextTranslationRepo.AssertNumberOfCalls(t, "Find", 1)
+	azureTranslationClient.AssertNumberOfCalls(t, "Translate", 1)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kujilabo/cocotola-translator-api/src/app/gateway/fake"
+)
+
+const readHeaderTimeout = time.Duration(30) * time.Second
+
+// fake-azure-translator serves canned dictionary/lookup, dictionary/examples and translate responses of the Azure Translator API.
+// Set azure.endpoint to http://localhost:<port> to use it instead of Azure.
+func main() {
+	port := flag.Int("port", 8190, "port")
+	file := flag.String("file", "", "JSON file of canned translations. The built-in ones are used if empty")
+	subscriptionKey := flag.String("subscriptionKey", "", "subscription key which requests must have")
+	region := flag.String("region", "", "region which requests must have")
+	flag.Parse()
+
+	dictionary, err := loadDictionary(*file)
+	if err != nil {
+		logrus.Fatalf("failed to load dictionary. err: %v", err)
+	}
+
+	server := http.Server{
+		Addr:              ":" + strconv.Itoa(*port),
+		Handler:           fake.NewAzureTranslatorHandler(*subscriptionKey, *region, dictionary),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	logrus.Infof("fake azure translator listening at %v", server.Addr)
+	if err := server.ListenAndServe(); err != nil {
+		logrus.Fatalf("failed to ListenAndServe. err: %v", err)
+	}
+}
+
+func loadDictionary(file string) (fake.AzureDictionary, error) {
+	if file == "" {
+		return fake.DefaultAzureDictionary()
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return fake.LoadAzureDictionary(f)
+}
@@ -0,0 +1,69 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kujilabo/cocotola-translator-api/src/lib/cache"
+)
+
+func Test_lruCache_evict(t *testing.T) {
+	// given
+	c := cache.NewLRUCache(2, time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	// when
+	// - "a" is used, then "c" is added
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	c.Set("c", 3)
+
+	// then
+	// - the least recently used "b" is evicted
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	v, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func Test_lruCache_expire(t *testing.T) {
+	// given
+	c := cache.NewLRUCache(2, 50*time.Millisecond)
+	c.Set("a", 1)
+
+	// when
+	time.Sleep(100 * time.Millisecond)
+
+	// then
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func Test_lruCache_Remove(t *testing.T) {
+	// given
+	c := cache.NewLRUCache(3, time.Minute)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	// when
+	c.Remove("a", "b", "x")
+
+	// then
+	assert.Equal(t, 1, c.Len())
+
+	// when
+	c.Purge()
+
+	// then
+	assert.Equal(t, 0, c.Len())
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"os"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/golang-migrate/migrate/v4"
@@ -22,13 +23,23 @@ func ConvertDuplicatedError(err error, newErr error) error {
 	}
 
 	var sqlite3Err sqlite3.Error
-	if ok := errors.As(err, &sqlite3Err); ok && int(sqlite3Err.ExtendedCode) == 2067 {
+	if ok := errors.As(err, &sqlite3Err); ok && (sqlite3Err.ExtendedCode == sqlite3.ErrConstraintUnique || sqlite3Err.ExtendedCode == sqlite3.ErrConstraintPrimaryKey) {
 		return newErr
 	}
 
 	return err
 }
 
+// LikeEscape is the escape character of the patterns which PrefixLikePattern returns. Use it as "column like ? escape '!'".
+const LikeEscape = "!"
+
+var likeEscaper = strings.NewReplacer(LikeEscape, LikeEscape+LikeEscape, "%", LikeEscape+"%", "_", LikeEscape+"_")
+
+// PrefixLikePattern returns the pattern of like which matches the strings starting with prefix
+func PrefixLikePattern(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
+
 func ConvertRelationError(err error, newErr error) error {
 	var mysqlErr *mysql.MySQLError
 	if ok := errors.As(err, &mysqlErr); ok && mysqlErr.Number == 1452 {
@@ -35,7 +35,9 @@ import (
 	"github.com/kujilabo/cocotola-translator-api/docs"
 	"github.com/kujilabo/cocotola-translator-api/src/app/config"
 	"github.com/kujilabo/cocotola-translator-api/src/app/controller"
+	"github.com/kujilabo/cocotola-translator-api/src/app/domain"
 	"github.com/kujilabo/cocotola-translator-api/src/app/gateway"
+	"github.com/kujilabo/cocotola-translator-api/src/app/service"
 	"github.com/kujilabo/cocotola-translator-api/src/app/usecase"
 	liberrors "github.com/kujilabo/cocotola-translator-api/src/lib/errors"
 	libG "github.com/kujilabo/cocotola-translator-api/src/lib/gateway"
@@ -69,16 +71,48 @@ func main() {
 	defer sqlDB.Close()
 	defer tp.ForceFlush(ctx) // flushes any pending spans
 
-	azureTranslationClient := gateway.NewAzureTranslationClient(cfg.Azure.SubscriptionKey)
-	rf, err := gateway.NewRepositoryFactory(ctx, db, cfg.DB.DriverName)
+	negativeCacheTTL := time.Duration(cfg.Translation.NegativeCacheTTLSec) * time.Second
+	var repositoryCache *gateway.RepositoryCache
+	if cfg.Cache != nil {
+		repositoryCache = gateway.NewRepositoryCache(cfg.Cache.Size, time.Duration(cfg.Cache.TTLSec)*time.Second)
+	}
+	rff := func(ctx context.Context, db *gorm.DB) (service.RepositoryFactory, error) {
+		rf, err := gateway.NewRepositoryFactory(ctx, db, cfg.DB.DriverName, negativeCacheTTL)
+		if err != nil {
+			return nil, err
+		}
+		if repositoryCache == nil {
+			return rf, nil
+		}
+		return gateway.NewCachedRepositoryFactory(ctx, rf, repositoryCache)
+	}
+	rf, err := rff(ctx, db)
+	if err != nil {
+		panic(err)
+	}
+	transactionManager := gateway.NewTransactionManager(db, rff)
+
+	registry, azureTranslationClient, err := newTranslationProviderRegistry(ctx, cfg)
+	if err != nil {
+		panic(err)
+	}
+	chain, err := newTranslationProviderChain(ctx, cfg.Translation, rf, registry)
 	if err != nil {
 		panic(err)
 	}
 
-	adminUsecase := usecase.NewAdminUsecase(rf)
-	userUsecase := usecase.NewUserUsecase(rf, azureTranslationClient)
+	adminUsecase := usecase.NewAdminUsecase(rf, transactionManager)
+	// examples and free text translations are available only with azure
+	var exampleProvider service.ExampleProvider
+	var textTranslator service.TextTranslator
+	if azureTranslationClient != nil {
+		exampleProvider = azureTranslationClient
+		textTranslator = azureTranslationClient
+	}
+	userUsecase := usecase.NewUserUsecase(rf, chain, exampleProvider, textTranslator)
+	refreshUsecase := usecase.NewRefreshUsecase(rf, chain)
 
-	result := run(context.Background(), cfg, db, adminUsecase, userUsecase)
+	result := run(context.Background(), cfg, db, adminUsecase, userUsecase, refreshUsecase)
 
 	gracefulShutdownTime2 := time.Duration(cfg.Shutdown.TimeSec2) * time.Second
 	time.Sleep(gracefulShutdownTime2)
@@ -86,7 +120,7 @@ func main() {
 	os.Exit(result)
 }
 
-func run(ctx context.Context, cfg *config.Config, db *gorm.DB, adminUsecase usecase.AdminUsecase, userUsecase usecase.UserUsecase) int {
+func run(ctx context.Context, cfg *config.Config, db *gorm.DB, adminUsecase usecase.AdminUsecase, userUsecase usecase.UserUsecase, refreshUsecase usecase.RefreshUsecase) int {
 	var eg *errgroup.Group
 	eg, ctx = errgroup.WithContext(ctx)
 
@@ -96,6 +130,11 @@ func run(ctx context.Context, cfg *config.Config, db *gorm.DB, adminUsecase usec
 	eg.Go(func() error {
 		return grpcServer(ctx, cfg, db, adminUsecase, userUsecase)
 	})
+	if cfg.Translation.Refresh != nil {
+		eg.Go(func() error {
+			return translationRefresher(ctx, cfg.Translation.Refresh, refreshUsecase)
+		})
+	}
 	eg.Go(func() error {
 		return libG.MetricsServerProcess(ctx, cfg.App.MetricsPort, cfg.Shutdown.TimeSec1)
 	})
@@ -188,9 +227,12 @@ func grpcServer(ctx context.Context, cfg *config.Config, db *gorm.DB, adminUseca
 	)
 	reflection.Register(grpcServer)
 
-	userServer := controller.NewTranslatorUserServer(userUsecase)
+	userServer := controller.NewTranslatorUserServer(userUsecase, cfg.Translation.StreamConcurrency)
 	pb.RegisterTranslatorUserServer(grpcServer, userServer)
 
+	adminServer := controller.NewTranslatorAdminServer(adminUsecase)
+	pb.RegisterTranslatorAdminServer(grpcServer, adminServer)
+
 	logrus.Printf("grpc server listening at %v", lis.Addr())
 
 	errCh := make(chan error)
@@ -211,6 +253,29 @@ func grpcServer(ctx context.Context, cfg *config.Config, db *gorm.DB, adminUseca
 	}
 }
 
+// translationRefresher looks up stale cached translations again every interval until ctx is done.
+// Failures are logged and retried in the next interval.
+func translationRefresher(ctx context.Context, cfg *config.RefreshConfig, refreshUsecase usecase.RefreshUsecase) error {
+	maxAge := time.Duration(cfg.MaxAgeSec) * time.Second
+	ticker := time.NewTicker(time.Duration(cfg.IntervalSec) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			refreshed, err := refreshUsecase.RefreshStaleTranslations(ctx, time.Now().Add(-maxAge), cfg.BatchSize)
+			if err != nil {
+				logrus.Warnf("failed to RefreshStaleTranslations. err: %v", err)
+			}
+			if refreshed > 0 {
+				logrus.Infof("refreshed translations. count: %d", refreshed)
+			}
+		}
+	}
+}
+
 func initialize(ctx context.Context, env string) (*config.Config, *gorm.DB, *sql.DB, *sdktrace.TracerProvider, error) {
 	cfg, err := config.LoadConfig(env)
 	if err != nil {
@@ -239,6 +304,55 @@ func initialize(ctx context.Context, env string) (*config.Config, *gorm.DB, *sql
 	return cfg, db, sqlDB, tp, nil
 }
 
+// newTranslationProviderRegistry returns the registry of the configured providers and the azure client, which is nil unless azure is configured
+func newTranslationProviderRegistry(ctx context.Context, cfg *config.Config) (service.TranslationProviderRegistry, service.AzureTranslationClient, error) {
+	registry := service.NewTranslationProviderRegistry()
+	var azureTranslationClient service.AzureTranslationClient
+
+	if cfg.Azure != nil {
+		azureTimeout := time.Duration(cfg.Azure.TimeoutSec) * time.Second
+		azureTranslationClient = gateway.NewAzureTranslationClient(cfg.Azure.Endpoint, cfg.Azure.Region, cfg.Azure.SubscriptionKey, azureTimeout)
+		if err := registry.Register(service.TranslationProviderAzure, azureTranslationClient); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if cfg.JMdict != nil {
+		jmdictProvider, err := gateway.NewJMdictTranslationProviderFromFile(ctx, cfg.JMdict.Format, cfg.JMdict.Path)
+		if err != nil {
+			return nil, nil, liberrors.Errorf("failed to NewJMdictTranslationProviderFromFile in main.newTranslationProviderRegistry. err: %w", err)
+		}
+		if err := registry.Register(service.TranslationProviderJMdict, jmdictProvider); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return registry, azureTranslationClient, nil
+}
+
+func newTranslationProviderChain(ctx context.Context, cfg *config.TranslationConfig, rf service.RepositoryFactory, registry service.TranslationProviderRegistry) ([]service.TranslationProviderChainItem, error) {
+	params := make([]service.TranslationProviderChainParameter, len(cfg.Providers))
+	for i, p := range cfg.Providers {
+		// cached providers must have their cache repositories. the source language does not matter here
+		if p.Cached {
+			if _, err := rf.NewTranslationCacheRepository(ctx, p.Name, domain.Lang2EN); err != nil {
+				return nil, liberrors.Errorf("failed to NewTranslationCacheRepository in main.newTranslationProviderChain. err: %w", err)
+			}
+		}
+
+		params[i] = service.TranslationProviderChainParameter{
+			Name:   p.Name,
+			Cached: p.Cached,
+		}
+	}
+
+	chain, err := registry.NewChain(params)
+	if err != nil {
+		return nil, liberrors.Errorf("failed to registry.NewChain in main.newTranslationProviderChain. err: %w", err)
+	}
+	return chain, nil
+}
+
 func NewAuthFunc(username, password string) func(ctx context.Context) (context.Context, error) {
 	data := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
 
@@ -252,6 +366,6 @@ func NewAuthFunc(username, password string) func(ctx context.Context) (context.C
 			return nil, status.Errorf(codes.Unauthenticated, "unauthenticated")
 		}
 
-		return ctx, nil
+		return service.WithActor(ctx, username), nil
 	}
 }
@@ -28,6 +28,14 @@ type TranslatorAdminClient interface {
 	AddTranslation(ctx context.Context, in *TranslationAddParameter, opts ...grpc.CallOption) (*TranslationAddResponse, error)
 	UpdateTranslation(ctx context.Context, in *TranslationUpdateParameter, opts ...grpc.CallOption) (*TranslationAddResponse, error)
 	RemoveTranslation(ctx context.Context, in *TranslationRemoveParameter, opts ...grpc.CallOption) (*TranslationRemoveResponse, error)
+	FindTranslationHistory(ctx context.Context, in *TranslationHistoryFindParameter, opts ...grpc.CallOption) (*TranslationHistoryFindResponse, error)
+	RevertTranslation(ctx context.Context, in *TranslationRevertParameter, opts ...grpc.CallOption) (*TranslationRevertResponse, error)
+	RestoreTranslation(ctx context.Context, in *TranslationRestoreParameter, opts ...grpc.CallOption) (*TranslationRestoreResponse, error)
+	FindDisabledTranslations(ctx context.Context, in *TranslationFindDisabledParameter, opts ...grpc.CallOption) (*TranslationFindResposne, error)
+	SearchTranslations(ctx context.Context, in *TranslationSearchParameter, opts ...grpc.CallOption) (*TranslationSearchResponse, error)
+	ImportTranslations(ctx context.Context, opts ...grpc.CallOption) (TranslatorAdmin_ImportTranslationsClient, error)
+	FindNegativeCaches(ctx context.Context, in *NegativeCacheFindParameter, opts ...grpc.CallOption) (*NegativeCacheFindResponse, error)
+	RemoveNegativeCaches(ctx context.Context, in *NegativeCacheRemoveParameter, opts ...grpc.CallOption) (*NegativeCacheRemoveResponse, error)
 }
 
 type translatorAdminClient struct {
@@ -92,6 +100,103 @@ func (c *translatorAdminClient) RemoveTranslation(ctx context.Context, in *Trans
 	return out, nil
 }
 
+func (c *translatorAdminClient) FindTranslationHistory(ctx context.Context, in *TranslationHistoryFindParameter, opts ...grpc.CallOption) (*TranslationHistoryFindResponse, error) {
+	out := new(TranslationHistoryFindResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/FindTranslationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) RevertTranslation(ctx context.Context, in *TranslationRevertParameter, opts ...grpc.CallOption) (*TranslationRevertResponse, error) {
+	out := new(TranslationRevertResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/RevertTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) RestoreTranslation(ctx context.Context, in *TranslationRestoreParameter, opts ...grpc.CallOption) (*TranslationRestoreResponse, error) {
+	out := new(TranslationRestoreResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/RestoreTranslation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) FindDisabledTranslations(ctx context.Context, in *TranslationFindDisabledParameter, opts ...grpc.CallOption) (*TranslationFindResposne, error) {
+	out := new(TranslationFindResposne)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/FindDisabledTranslations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) SearchTranslations(ctx context.Context, in *TranslationSearchParameter, opts ...grpc.CallOption) (*TranslationSearchResponse, error) {
+	out := new(TranslationSearchResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/SearchTranslations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) ImportTranslations(ctx context.Context, opts ...grpc.CallOption) (TranslatorAdmin_ImportTranslationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TranslatorAdmin_ServiceDesc.Streams[0], "/proto.TranslatorAdmin/ImportTranslations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &translatorAdminImportTranslationsClient{stream}
+	return x, nil
+}
+
+type TranslatorAdmin_ImportTranslationsClient interface {
+	Send(*TranslationImportParameter) error
+	CloseAndRecv() (*TranslationImportResponse, error)
+	grpc.ClientStream
+}
+
+type translatorAdminImportTranslationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *translatorAdminImportTranslationsClient) Send(m *TranslationImportParameter) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *translatorAdminImportTranslationsClient) CloseAndRecv() (*TranslationImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(TranslationImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *translatorAdminClient) FindNegativeCaches(ctx context.Context, in *NegativeCacheFindParameter, opts ...grpc.CallOption) (*NegativeCacheFindResponse, error) {
+	out := new(NegativeCacheFindResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/FindNegativeCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorAdminClient) RemoveNegativeCaches(ctx context.Context, in *NegativeCacheRemoveParameter, opts ...grpc.CallOption) (*NegativeCacheRemoveResponse, error) {
+	out := new(NegativeCacheRemoveResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorAdmin/RemoveNegativeCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TranslatorAdminServer is the server API for TranslatorAdmin service.
 // All implementations must embed UnimplementedTranslatorAdminServer
 // for forward compatibility
@@ -102,6 +207,14 @@ type TranslatorAdminServer interface {
 	AddTranslation(context.Context, *TranslationAddParameter) (*TranslationAddResponse, error)
 	UpdateTranslation(context.Context, *TranslationUpdateParameter) (*TranslationAddResponse, error)
 	RemoveTranslation(context.Context, *TranslationRemoveParameter) (*TranslationRemoveResponse, error)
+	FindTranslationHistory(context.Context, *TranslationHistoryFindParameter) (*TranslationHistoryFindResponse, error)
+	RevertTranslation(context.Context, *TranslationRevertParameter) (*TranslationRevertResponse, error)
+	RestoreTranslation(context.Context, *TranslationRestoreParameter) (*TranslationRestoreResponse, error)
+	FindDisabledTranslations(context.Context, *TranslationFindDisabledParameter) (*TranslationFindResposne, error)
+	SearchTranslations(context.Context, *TranslationSearchParameter) (*TranslationSearchResponse, error)
+	ImportTranslations(TranslatorAdmin_ImportTranslationsServer) error
+	FindNegativeCaches(context.Context, *NegativeCacheFindParameter) (*NegativeCacheFindResponse, error)
+	RemoveNegativeCaches(context.Context, *NegativeCacheRemoveParameter) (*NegativeCacheRemoveResponse, error)
 	mustEmbedUnimplementedTranslatorAdminServer()
 }
 
@@ -127,6 +240,30 @@ func (UnimplementedTranslatorAdminServer) UpdateTranslation(context.Context, *Tr
 func (UnimplementedTranslatorAdminServer) RemoveTranslation(context.Context, *TranslationRemoveParameter) (*TranslationRemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTranslation not implemented")
 }
+func (UnimplementedTranslatorAdminServer) FindTranslationHistory(context.Context, *TranslationHistoryFindParameter) (*TranslationHistoryFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTranslationHistory not implemented")
+}
+func (UnimplementedTranslatorAdminServer) RevertTranslation(context.Context, *TranslationRevertParameter) (*TranslationRevertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTranslation not implemented")
+}
+func (UnimplementedTranslatorAdminServer) RestoreTranslation(context.Context, *TranslationRestoreParameter) (*TranslationRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTranslation not implemented")
+}
+func (UnimplementedTranslatorAdminServer) FindDisabledTranslations(context.Context, *TranslationFindDisabledParameter) (*TranslationFindResposne, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDisabledTranslations not implemented")
+}
+func (UnimplementedTranslatorAdminServer) SearchTranslations(context.Context, *TranslationSearchParameter) (*TranslationSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTranslations not implemented")
+}
+func (UnimplementedTranslatorAdminServer) ImportTranslations(TranslatorAdmin_ImportTranslationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTranslations not implemented")
+}
+func (UnimplementedTranslatorAdminServer) FindNegativeCaches(context.Context, *NegativeCacheFindParameter) (*NegativeCacheFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNegativeCaches not implemented")
+}
+func (UnimplementedTranslatorAdminServer) RemoveNegativeCaches(context.Context, *NegativeCacheRemoveParameter) (*NegativeCacheRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNegativeCaches not implemented")
+}
 func (UnimplementedTranslatorAdminServer) mustEmbedUnimplementedTranslatorAdminServer() {}
 
 // UnsafeTranslatorAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +385,158 @@ func _TranslatorAdmin_RemoveTranslation_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TranslatorAdmin_FindTranslationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslationHistoryFindParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).FindTranslationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/FindTranslationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).FindTranslationHistory(ctx, req.(*TranslationHistoryFindParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_RevertTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslationRevertParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).RevertTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/RevertTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).RevertTranslation(ctx, req.(*TranslationRevertParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_RestoreTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslationRestoreParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).RestoreTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/RestoreTranslation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).RestoreTranslation(ctx, req.(*TranslationRestoreParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_FindDisabledTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslationFindDisabledParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).FindDisabledTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/FindDisabledTranslations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).FindDisabledTranslations(ctx, req.(*TranslationFindDisabledParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_SearchTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslationSearchParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).SearchTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/SearchTranslations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).SearchTranslations(ctx, req.(*TranslationSearchParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_ImportTranslations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TranslatorAdminServer).ImportTranslations(&translatorAdminImportTranslationsServer{stream})
+}
+
+type TranslatorAdmin_ImportTranslationsServer interface {
+	SendAndClose(*TranslationImportResponse) error
+	Recv() (*TranslationImportParameter, error)
+	grpc.ServerStream
+}
+
+type translatorAdminImportTranslationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *translatorAdminImportTranslationsServer) SendAndClose(m *TranslationImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *translatorAdminImportTranslationsServer) Recv() (*TranslationImportParameter, error) {
+	m := new(TranslationImportParameter)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TranslatorAdmin_FindNegativeCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NegativeCacheFindParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).FindNegativeCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/FindNegativeCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).FindNegativeCaches(ctx, req.(*NegativeCacheFindParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorAdmin_RemoveNegativeCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NegativeCacheRemoveParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorAdminServer).RemoveNegativeCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorAdmin/RemoveNegativeCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorAdminServer).RemoveNegativeCaches(ctx, req.(*NegativeCacheRemoveParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TranslatorAdmin_ServiceDesc is the grpc.ServiceDesc for TranslatorAdmin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,7 +568,41 @@ var TranslatorAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTranslation",
 			Handler:    _TranslatorAdmin_RemoveTranslation_Handler,
 		},
+		{
+			MethodName: "FindTranslationHistory",
+			Handler:    _TranslatorAdmin_FindTranslationHistory_Handler,
+		},
+		{
+			MethodName: "RevertTranslation",
+			Handler:    _TranslatorAdmin_RevertTranslation_Handler,
+		},
+		{
+			MethodName: "RestoreTranslation",
+			Handler:    _TranslatorAdmin_RestoreTranslation_Handler,
+		},
+		{
+			MethodName: "FindDisabledTranslations",
+			Handler:    _TranslatorAdmin_FindDisabledTranslations_Handler,
+		},
+		{
+			MethodName: "SearchTranslations",
+			Handler:    _TranslatorAdmin_SearchTranslations_Handler,
+		},
+		{
+			MethodName: "FindNegativeCaches",
+			Handler:    _TranslatorAdmin_FindNegativeCaches_Handler,
+		},
+		{
+			MethodName: "RemoveNegativeCaches",
+			Handler:    _TranslatorAdmin_RemoveNegativeCaches_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportTranslations",
+			Handler:       _TranslatorAdmin_ImportTranslations_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/translator_admin.proto",
 }
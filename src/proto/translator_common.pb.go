@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.6.1
+// source: proto/translator_common.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a translation of the translated text back into the language of the text
+type BackTranslation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text           string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	FrequencyCount int32  `protobuf:"varint,2,opt,name=frequencyCount,proto3" json:"frequencyCount,omitempty"`
+}
+
+func (x *BackTranslation) Reset() {
+	*x = BackTranslation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_common_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackTranslation) ProtoMessage() {}
+
+func (x *BackTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_common_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackTranslation.ProtoReflect.Descriptor instead.
+func (*BackTranslation) Descriptor() ([]byte, []int) {
+	return file_proto_translator_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BackTranslation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *BackTranslation) GetFrequencyCount() int32 {
+	if x != nil {
+		return x.FrequencyCount
+	}
+	return 0
+}
+
+// a meaning of the custom translation
+type Sense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gloss string `protobuf:"bytes,1,opt,name=gloss,proto3" json:"gloss,omitempty"`
+	Note  string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// order is the display order
+	Order int32 `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *Sense) Reset() {
+	*x = Sense{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_common_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sense) ProtoMessage() {}
+
+func (x *Sense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_common_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sense.ProtoReflect.Descriptor instead.
+func (*Sense) Descriptor() ([]byte, []int) {
+	return file_proto_translator_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Sense) GetGloss() string {
+	if x != nil {
+		return x.Gloss
+	}
+	return ""
+}
+
+func (x *Sense) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Sense) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+var File_proto_translator_common_proto protoreflect.FileDescriptor
+
+var file_proto_translator_common_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x05, 0x53, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x6c, 0x6f, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x0a, 0x21, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x42, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6a, 0x69, 0x6c, 0x61, 0x62,
+	0x6f, 0x2f, 0x63, 0x6f, 0x63, 0x6f, 0x74, 0x6f, 0x6c, 0x61, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_translator_common_proto_rawDescOnce sync.Once
+	file_proto_translator_common_proto_rawDescData = file_proto_translator_common_proto_rawDesc
+)
+
+func file_proto_translator_common_proto_rawDescGZIP() []byte {
+	file_proto_translator_common_proto_rawDescOnce.Do(func() {
+		file_proto_translator_common_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_translator_common_proto_rawDescData)
+	})
+	return file_proto_translator_common_proto_rawDescData
+}
+
+var file_proto_translator_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_translator_common_proto_goTypes = []interface{}{
+	(*BackTranslation)(nil), // 0: proto.BackTranslation
+	(*Sense)(nil),           // 1: proto.Sense
+}
+var file_proto_translator_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_translator_common_proto_init() }
+func file_proto_translator_common_proto_init() {
+	if File_proto_translator_common_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_translator_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackTranslation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_common_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sense); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_translator_common_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_translator_common_proto_goTypes,
+		DependencyIndexes: file_proto_translator_common_proto_depIdxs,
+		MessageInfos:      file_proto_translator_common_proto_msgTypes,
+	}.Build()
+	File_proto_translator_common_proto = out.File
+	file_proto_translator_common_proto_rawDesc = nil
+	file_proto_translator_common_proto_goTypes = nil
+	file_proto_translator_common_proto_depIdxs = nil
+}
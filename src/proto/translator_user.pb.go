@@ -20,14 +20,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LookupMode int32
+
+const (
+	// the best translation for each pos
+	LookupMode_LOOKUP_MODE_BEST LookupMode = 0
+	// all the translations ranked for each pos
+	LookupMode_LOOKUP_MODE_ALL LookupMode = 1
+)
+
+// Enum value maps for LookupMode.
+var (
+	LookupMode_name = map[int32]string{
+		0: "LOOKUP_MODE_BEST",
+		1: "LOOKUP_MODE_ALL",
+	}
+	LookupMode_value = map[string]int32{
+		"LOOKUP_MODE_BEST": 0,
+		"LOOKUP_MODE_ALL":  1,
+	}
+)
+
+func (x LookupMode) Enum() *LookupMode {
+	p := new(LookupMode)
+	*p = x
+	return p
+}
+
+func (x LookupMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LookupMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_translator_user_proto_enumTypes[0].Descriptor()
+}
+
+func (LookupMode) Type() protoreflect.EnumType {
+	return &file_proto_translator_user_proto_enumTypes[0]
+}
+
+func (x LookupMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LookupMode.Descriptor instead.
+func (LookupMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{0}
+}
+
 type DictionaryLookupParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromLang2 string `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
-	ToLang2   string `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
-	Text      string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	FromLang2 string     `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2   string     `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Text      string     `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Mode      LookupMode `protobuf:"varint,4,opt,name=mode,proto3,enum=proto.LookupMode" json:"mode,omitempty"`
 }
 
 func (x *DictionaryLookupParameter) Reset() {
@@ -83,6 +132,13 @@ func (x *DictionaryLookupParameter) GetText() string {
 	return ""
 }
 
+func (x *DictionaryLookupParameter) GetMode() LookupMode {
+	if x != nil {
+		return x.Mode
+	}
+	return LookupMode_LOOKUP_MODE_BEST
+}
+
 type DictionaryLookupWithPosParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -154,20 +210,18 @@ func (x *DictionaryLookupWithPosParameter) GetPos() int32 {
 	return 0
 }
 
-type DictionaryResponse struct {
+type DictionaryLookupBatchParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lang2      string `protobuf:"bytes,1,opt,name=lang2,proto3" json:"lang2,omitempty"`
-	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	Pos        int32  `protobuf:"varint,3,opt,name=pos,proto3" json:"pos,omitempty"`
-	Translated string `protobuf:"bytes,4,opt,name=translated,proto3" json:"translated,omitempty"`
-	Provider   string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	FromLang2 string   `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2   string   `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Texts     []string `protobuf:"bytes,3,rep,name=texts,proto3" json:"texts,omitempty"`
 }
 
-func (x *DictionaryResponse) Reset() {
-	*x = DictionaryResponse{}
+func (x *DictionaryLookupBatchParameter) Reset() {
+	*x = DictionaryLookupBatchParameter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_translator_user_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,13 +229,13 @@ func (x *DictionaryResponse) Reset() {
 	}
 }
 
-func (x *DictionaryResponse) String() string {
+func (x *DictionaryLookupBatchParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryResponse) ProtoMessage() {}
+func (*DictionaryLookupBatchParameter) ProtoMessage() {}
 
-func (x *DictionaryResponse) ProtoReflect() protoreflect.Message {
+func (x *DictionaryLookupBatchParameter) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_translator_user_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -193,71 +247,126 @@ func (x *DictionaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryResponse.ProtoReflect.Descriptor instead.
-func (*DictionaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DictionaryLookupBatchParameter.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupBatchParameter) Descriptor() ([]byte, []int) {
 	return file_proto_translator_user_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DictionaryResponse) GetLang2() string {
+func (x *DictionaryLookupBatchParameter) GetFromLang2() string {
 	if x != nil {
-		return x.Lang2
+		return x.FromLang2
 	}
 	return ""
 }
 
-func (x *DictionaryResponse) GetText() string {
+func (x *DictionaryLookupBatchParameter) GetToLang2() string {
 	if x != nil {
-		return x.Text
+		return x.ToLang2
 	}
 	return ""
 }
 
-func (x *DictionaryResponse) GetPos() int32 {
+func (x *DictionaryLookupBatchParameter) GetTexts() []string {
 	if x != nil {
-		return x.Pos
+		return x.Texts
 	}
-	return 0
+	return nil
 }
 
-func (x *DictionaryResponse) GetTranslated() string {
+// fromLang2 and toLang2 of the first message apply to the whole stream. the later messages may omit them but must not change them
+type DictionaryLookupStreamParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromLang2 string   `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2   string   `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Texts     []string `protobuf:"bytes,3,rep,name=texts,proto3" json:"texts,omitempty"`
+}
+
+func (x *DictionaryLookupStreamParameter) Reset() {
+	*x = DictionaryLookupStreamParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryLookupStreamParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryLookupStreamParameter) ProtoMessage() {}
+
+func (x *DictionaryLookupStreamParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryLookupStreamParameter.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupStreamParameter) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DictionaryLookupStreamParameter) GetFromLang2() string {
 	if x != nil {
-		return x.Translated
+		return x.FromLang2
 	}
 	return ""
 }
 
-func (x *DictionaryResponse) GetProvider() string {
+func (x *DictionaryLookupStreamParameter) GetToLang2() string {
 	if x != nil {
-		return x.Provider
+		return x.ToLang2
 	}
 	return ""
 }
 
-type DictionaryLookupResponses struct {
+func (x *DictionaryLookupStreamParameter) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+// texts of fromLang2 are found from their translation of toLang2
+type DictionaryReverseLookupParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*DictionaryResponse `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	FromLang2  string `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2    string `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Translated string `protobuf:"bytes,3,opt,name=translated,proto3" json:"translated,omitempty"`
+	// the translations starting with translated are found if prefix is true
+	Prefix bool `protobuf:"varint,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
 }
 
-func (x *DictionaryLookupResponses) Reset() {
-	*x = DictionaryLookupResponses{}
+func (x *DictionaryReverseLookupParameter) Reset() {
+	*x = DictionaryReverseLookupParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_translator_user_proto_msgTypes[3]
+		mi := &file_proto_translator_user_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryLookupResponses) String() string {
+func (x *DictionaryReverseLookupParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryLookupResponses) ProtoMessage() {}
+func (*DictionaryReverseLookupParameter) ProtoMessage() {}
 
-func (x *DictionaryLookupResponses) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_translator_user_proto_msgTypes[3]
+func (x *DictionaryReverseLookupParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,43 +377,67 @@ func (x *DictionaryLookupResponses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryLookupResponses.ProtoReflect.Descriptor instead.
-func (*DictionaryLookupResponses) Descriptor() ([]byte, []int) {
-	return file_proto_translator_user_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DictionaryReverseLookupParameter.ProtoReflect.Descriptor instead.
+func (*DictionaryReverseLookupParameter) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DictionaryLookupResponses) GetResults() []*DictionaryResponse {
+func (x *DictionaryReverseLookupParameter) GetFromLang2() string {
 	if x != nil {
-		return x.Results
+		return x.FromLang2
 	}
-	return nil
+	return ""
 }
 
-type DictionaryLookupResponse struct {
+func (x *DictionaryReverseLookupParameter) GetToLang2() string {
+	if x != nil {
+		return x.ToLang2
+	}
+	return ""
+}
+
+func (x *DictionaryReverseLookupParameter) GetTranslated() string {
+	if x != nil {
+		return x.Translated
+	}
+	return ""
+}
+
+func (x *DictionaryReverseLookupParameter) GetPrefix() bool {
+	if x != nil {
+		return x.Prefix
+	}
+	return false
+}
+
+type DictionaryExamplesParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *DictionaryResponse `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	FromLang2  string `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2    string `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Text       string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Translated string `protobuf:"bytes,4,opt,name=translated,proto3" json:"translated,omitempty"`
 }
 
-func (x *DictionaryLookupResponse) Reset() {
-	*x = DictionaryLookupResponse{}
+func (x *DictionaryExamplesParameter) Reset() {
+	*x = DictionaryExamplesParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_translator_user_proto_msgTypes[4]
+		mi := &file_proto_translator_user_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DictionaryLookupResponse) String() string {
+func (x *DictionaryExamplesParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DictionaryLookupResponse) ProtoMessage() {}
+func (*DictionaryExamplesParameter) ProtoMessage() {}
 
-func (x *DictionaryLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_translator_user_proto_msgTypes[4]
+func (x *DictionaryExamplesParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,169 +448,1150 @@ func (x *DictionaryLookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DictionaryLookupResponse.ProtoReflect.Descriptor instead.
-func (*DictionaryLookupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_translator_user_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DictionaryExamplesParameter.ProtoReflect.Descriptor instead.
+func (*DictionaryExamplesParameter) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DictionaryLookupResponse) GetResult() *DictionaryResponse {
+func (x *DictionaryExamplesParameter) GetFromLang2() string {
 	if x != nil {
-		return x.Result
+		return x.FromLang2
 	}
-	return nil
+	return ""
 }
 
-var File_proto_translator_user_proto protoreflect.FileDescriptor
+func (x *DictionaryExamplesParameter) GetToLang2() string {
+	if x != nil {
+		return x.ToLang2
+	}
+	return ""
+}
 
-var file_proto_translator_user_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x19, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x80, 0x01,
-	0x0a, 0x20, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32,
-	0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73,
-	0x22, 0x8c, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x6e, 0x67, 0x32,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x70, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
-	0x50, 0x0a, 0x19, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x22, 0x4d, 0x0a, 0x18, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x32, 0xd1, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x10, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
-	0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x65, 0x0a,
-	0x17, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x6b, 0x0a, 0x1f, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x75, 0x73, 0x65, 0x72, 0x42, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6a, 0x69, 0x6c,
-	0x61, 0x62, 0x6f, 0x2f, 0x63, 0x6f, 0x63, 0x6f, 0x74, 0x6f, 0x6c, 0x61, 0x2d, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *DictionaryExamplesParameter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-var (
-	file_proto_translator_user_proto_rawDescOnce sync.Once
-	file_proto_translator_user_proto_rawDescData = file_proto_translator_user_proto_rawDesc
-)
+func (x *DictionaryExamplesParameter) GetTranslated() string {
+	if x != nil {
+		return x.Translated
+	}
+	return ""
+}
 
-func file_proto_translator_user_proto_rawDescGZIP() []byte {
-	file_proto_translator_user_proto_rawDescOnce.Do(func() {
-		file_proto_translator_user_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_translator_user_proto_rawDescData)
-	})
-	return file_proto_translator_user_proto_rawDescData
+// the language of the text is detected if fromLang2 is empty
+type TranslateParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromLang2 string `protobuf:"bytes,1,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2   string `protobuf:"bytes,2,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Text      string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-var file_proto_translator_user_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_proto_translator_user_proto_goTypes = []interface{}{
-	(*DictionaryLookupParameter)(nil),        // 0: proto.DictionaryLookupParameter
-	(*DictionaryLookupWithPosParameter)(nil), // 1: proto.DictionaryLookupWithPosParameter
-	(*DictionaryResponse)(nil),               // 2: proto.DictionaryResponse
-	(*DictionaryLookupResponses)(nil),        // 3: proto.DictionaryLookupResponses
-	(*DictionaryLookupResponse)(nil),         // 4: proto.DictionaryLookupResponse
+func (x *TranslateParameter) Reset() {
+	*x = TranslateParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_proto_translator_user_proto_depIdxs = []int32{
-	2, // 0: proto.DictionaryLookupResponses.Results:type_name -> proto.DictionaryResponse
-	2, // 1: proto.DictionaryLookupResponse.Result:type_name -> proto.DictionaryResponse
-	0, // 2: proto.TranslatorUser.DictionaryLookup:input_type -> proto.DictionaryLookupParameter
-	1, // 3: proto.TranslatorUser.DictionaryLookupWithPos:input_type -> proto.DictionaryLookupWithPosParameter
-	3, // 4: proto.TranslatorUser.DictionaryLookup:output_type -> proto.DictionaryLookupResponses
-	4, // 5: proto.TranslatorUser.DictionaryLookupWithPos:output_type -> proto.DictionaryLookupResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *TranslateParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_proto_translator_user_proto_init() }
-func file_proto_translator_user_proto_init() {
-	if File_proto_translator_user_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_translator_user_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryLookupParameter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_translator_user_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryLookupWithPosParameter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_translator_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_translator_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DictionaryLookupResponses); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*TranslateParameter) ProtoMessage() {}
+
+func (x *TranslateParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateParameter.ProtoReflect.Descriptor instead.
+func (*TranslateParameter) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TranslateParameter) GetFromLang2() string {
+	if x != nil {
+		return x.FromLang2
+	}
+	return ""
+}
+
+func (x *TranslateParameter) GetToLang2() string {
+	if x != nil {
+		return x.ToLang2
+	}
+	return ""
+}
+
+func (x *TranslateParameter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DictionaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lang2      string `protobuf:"bytes,1,opt,name=lang2,proto3" json:"lang2,omitempty"`
+	Text       string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Pos        int32  `protobuf:"varint,3,opt,name=pos,proto3" json:"pos,omitempty"`
+	Translated string `protobuf:"bytes,4,opt,name=translated,proto3" json:"translated,omitempty"`
+	Provider   string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	// confidence is between 0 and 1. custom translations have 1.
+	Confidence float64 `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// rank is set only in LOOKUP_MODE_ALL. it starts with 1 for each pos.
+	Rank             int32              `protobuf:"varint,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	NormalizedSource string             `protobuf:"bytes,8,opt,name=normalizedSource,proto3" json:"normalizedSource,omitempty"`
+	PrefixWord       string             `protobuf:"bytes,9,opt,name=prefixWord,proto3" json:"prefixWord,omitempty"`
+	BackTranslations []*BackTranslation `protobuf:"bytes,10,rep,name=backTranslations,proto3" json:"backTranslations,omitempty"`
+	// senses are set only for custom translations. translated is the glosses of them joined with commas.
+	Senses []*Sense `protobuf:"bytes,11,rep,name=senses,proto3" json:"senses,omitempty"`
+	// labels, tags and level are set only for custom translations
+	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
+	Tags   []string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Level  string   `protobuf:"bytes,14,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *DictionaryResponse) Reset() {
+	*x = DictionaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryResponse) ProtoMessage() {}
+
+func (x *DictionaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryResponse.ProtoReflect.Descriptor instead.
+func (*DictionaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DictionaryResponse) GetLang2() string {
+	if x != nil {
+		return x.Lang2
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetPos() int32 {
+	if x != nil {
+		return x.Pos
+	}
+	return 0
+}
+
+func (x *DictionaryResponse) GetTranslated() string {
+	if x != nil {
+		return x.Translated
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DictionaryResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DictionaryResponse) GetNormalizedSource() string {
+	if x != nil {
+		return x.NormalizedSource
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetPrefixWord() string {
+	if x != nil {
+		return x.PrefixWord
+	}
+	return ""
+}
+
+func (x *DictionaryResponse) GetBackTranslations() []*BackTranslation {
+	if x != nil {
+		return x.BackTranslations
+	}
+	return nil
+}
+
+func (x *DictionaryResponse) GetSenses() []*Sense {
+	if x != nil {
+		return x.Senses
+	}
+	return nil
+}
+
+func (x *DictionaryResponse) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DictionaryResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DictionaryResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type DictionaryLookupResponses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*DictionaryResponse `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *DictionaryLookupResponses) Reset() {
+	*x = DictionaryLookupResponses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryLookupResponses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryLookupResponses) ProtoMessage() {}
+
+func (x *DictionaryLookupResponses) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryLookupResponses.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupResponses) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DictionaryLookupResponses) GetResults() []*DictionaryResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DictionaryLookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *DictionaryResponse `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *DictionaryLookupResponse) Reset() {
+	*x = DictionaryLookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryLookupResponse) ProtoMessage() {}
+
+func (x *DictionaryLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryLookupResponse.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DictionaryLookupResponse) GetResult() *DictionaryResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DictionaryLookupBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keyed by text
+	Results map[string]*DictionaryLookupResponses `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DictionaryLookupBatchResponse) Reset() {
+	*x = DictionaryLookupBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryLookupBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryLookupBatchResponse) ProtoMessage() {}
+
+func (x *DictionaryLookupBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryLookupBatchResponse.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DictionaryLookupBatchResponse) GetResults() map[string]*DictionaryLookupResponses {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DictionaryLookupStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string                `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Results []*DictionaryResponse `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// code is one of google.golang.org/grpc/codes. results are empty unless code is OK.
+	Code    int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DictionaryLookupStreamResponse) Reset() {
+	*x = DictionaryLookupStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryLookupStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryLookupStreamResponse) ProtoMessage() {}
+
+func (x *DictionaryLookupStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryLookupStreamResponse.ProtoReflect.Descriptor instead.
+func (*DictionaryLookupStreamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DictionaryLookupStreamResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DictionaryLookupStreamResponse) GetResults() []*DictionaryResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DictionaryLookupStreamResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DictionaryLookupStreamResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// the term is highlighted between the prefix and the suffix
+type ExampleSentence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Term   string `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Suffix string `protobuf:"bytes,3,opt,name=suffix,proto3" json:"suffix,omitempty"`
+}
+
+func (x *ExampleSentence) Reset() {
+	*x = ExampleSentence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExampleSentence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExampleSentence) ProtoMessage() {}
+
+func (x *ExampleSentence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExampleSentence.ProtoReflect.Descriptor instead.
+func (*ExampleSentence) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExampleSentence) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ExampleSentence) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *ExampleSentence) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+type Example struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source *ExampleSentence `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target *ExampleSentence `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *Example) Reset() {
+	*x = Example{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Example) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Example) ProtoMessage() {}
+
+func (x *Example) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Example.ProtoReflect.Descriptor instead.
+func (*Example) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Example) GetSource() *ExampleSentence {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Example) GetTarget() *ExampleSentence {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type DictionaryExamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lang2      string     `protobuf:"bytes,1,opt,name=lang2,proto3" json:"lang2,omitempty"`
+	Text       string     `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Translated string     `protobuf:"bytes,3,opt,name=translated,proto3" json:"translated,omitempty"`
+	Results    []*Example `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *DictionaryExamplesResponse) Reset() {
+	*x = DictionaryExamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryExamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryExamplesResponse) ProtoMessage() {}
+
+func (x *DictionaryExamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryExamplesResponse.ProtoReflect.Descriptor instead.
+func (*DictionaryExamplesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DictionaryExamplesResponse) GetLang2() string {
+	if x != nil {
+		return x.Lang2
+	}
+	return ""
+}
+
+func (x *DictionaryExamplesResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DictionaryExamplesResponse) GetTranslated() string {
+	if x != nil {
+		return x.Translated
+	}
+	return ""
+}
+
+func (x *DictionaryExamplesResponse) GetResults() []*Example {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TranslateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// the detected language if detected is true
+	FromLang2  string `protobuf:"bytes,2,opt,name=fromLang2,proto3" json:"fromLang2,omitempty"`
+	ToLang2    string `protobuf:"bytes,3,opt,name=toLang2,proto3" json:"toLang2,omitempty"`
+	Translated string `protobuf:"bytes,4,opt,name=translated,proto3" json:"translated,omitempty"`
+	Detected   bool   `protobuf:"varint,5,opt,name=detected,proto3" json:"detected,omitempty"`
+	// the confidence of the detected language between 0 and 1
+	DetectedLangScore float64 `protobuf:"fixed64,6,opt,name=detectedLangScore,proto3" json:"detectedLangScore,omitempty"`
+}
+
+func (x *TranslateResponse) Reset() {
+	*x = TranslateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_translator_user_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateResponse) ProtoMessage() {}
+
+func (x *TranslateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_translator_user_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateResponse.ProtoReflect.Descriptor instead.
+func (*TranslateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_translator_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TranslateResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TranslateResponse) GetFromLang2() string {
+	if x != nil {
+		return x.FromLang2
+	}
+	return ""
+}
+
+func (x *TranslateResponse) GetToLang2() string {
+	if x != nil {
+		return x.ToLang2
+	}
+	return ""
+}
+
+func (x *TranslateResponse) GetTranslated() string {
+	if x != nil {
+		return x.Translated
+	}
+	return ""
+}
+
+func (x *TranslateResponse) GetDetected() bool {
+	if x != nil {
+		return x.Detected
+	}
+	return false
+}
+
+func (x *TranslateResponse) GetDetectedLangScore() float64 {
+	if x != nil {
+		return x.DetectedLangScore
+	}
+	return 0
+}
+
+var File_proto_translator_user_proto protoreflect.FileDescriptor
+
+var file_proto_translator_user_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x19, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x25, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x20, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e,
+	0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67,
+	0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x22, 0x6e, 0x0a, 0x1e, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e,
+	0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67,
+	0x32, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0x6f, 0x0a, 0x1f, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x4c, 0x61,
+	0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e,
+	0x67, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x20, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x89, 0x01,
+	0x0a, 0x1b, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb8, 0x03, 0x0a, 0x12,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2a,
+	0x0a, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x57, 0x6f, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x10, 0x62, 0x61,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x61,
+	0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x50, 0x0a, 0x19, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x18, 0x44, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x44, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x1e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x55,
+	0x0a, 0x0f, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0x69, 0x0a, 0x07, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x22, 0x90, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x6f, 0x4c, 0x61, 0x6e, 0x67, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x4c, 0x61, 0x6e, 0x67, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e,
+	0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x2a,
+	0x37, 0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x45, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x32, 0xb3, 0x05, 0x0a, 0x0e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x10, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x17, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73,
+	0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x73,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x17, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x27,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x6b,
+	0x0a, 0x1f, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x75, 0x73, 0x65,
+	0x72, 0x42, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x6a, 0x69, 0x6c, 0x61, 0x62, 0x6f, 0x2f, 0x63, 0x6f,
+	0x63, 0x6f, 0x74, 0x6f, 0x6c, 0x61, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_translator_user_proto_rawDescOnce sync.Once
+	file_proto_translator_user_proto_rawDescData = file_proto_translator_user_proto_rawDesc
+)
+
+func file_proto_translator_user_proto_rawDescGZIP() []byte {
+	file_proto_translator_user_proto_rawDescOnce.Do(func() {
+		file_proto_translator_user_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_translator_user_proto_rawDescData)
+	})
+	return file_proto_translator_user_proto_rawDescData
+}
+
+var file_proto_translator_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_translator_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_translator_user_proto_goTypes = []interface{}{
+	(LookupMode)(0),                          // 0: proto.LookupMode
+	(*DictionaryLookupParameter)(nil),        // 1: proto.DictionaryLookupParameter
+	(*DictionaryLookupWithPosParameter)(nil), // 2: proto.DictionaryLookupWithPosParameter
+	(*DictionaryLookupBatchParameter)(nil),   // 3: proto.DictionaryLookupBatchParameter
+	(*DictionaryLookupStreamParameter)(nil),  // 4: proto.DictionaryLookupStreamParameter
+	(*DictionaryReverseLookupParameter)(nil), // 5: proto.DictionaryReverseLookupParameter
+	(*DictionaryExamplesParameter)(nil),      // 6: proto.DictionaryExamplesParameter
+	(*TranslateParameter)(nil),               // 7: proto.TranslateParameter
+	(*DictionaryResponse)(nil),               // 8: proto.DictionaryResponse
+	(*DictionaryLookupResponses)(nil),        // 9: proto.DictionaryLookupResponses
+	(*DictionaryLookupResponse)(nil),         // 10: proto.DictionaryLookupResponse
+	(*DictionaryLookupBatchResponse)(nil),    // 11: proto.DictionaryLookupBatchResponse
+	(*DictionaryLookupStreamResponse)(nil),   // 12: proto.DictionaryLookupStreamResponse
+	(*ExampleSentence)(nil),                  // 13: proto.ExampleSentence
+	(*Example)(nil),                          // 14: proto.Example
+	(*DictionaryExamplesResponse)(nil),       // 15: proto.DictionaryExamplesResponse
+	(*TranslateResponse)(nil),                // 16: proto.TranslateResponse
+	nil,                                      // 17: proto.DictionaryLookupBatchResponse.ResultsEntry
+	(*BackTranslation)(nil),                  // 18: proto.BackTranslation
+	(*Sense)(nil),                            // 19: proto.Sense
+}
+var file_proto_translator_user_proto_depIdxs = []int32{
+	0,  // 0: proto.DictionaryLookupParameter.mode:type_name -> proto.LookupMode
+	18, // 1: proto.DictionaryResponse.backTranslations:type_name -> proto.BackTranslation
+	19, // 2: proto.DictionaryResponse.senses:type_name -> proto.Sense
+	8,  // 3: proto.DictionaryLookupResponses.Results:type_name -> proto.DictionaryResponse
+	8,  // 4: proto.DictionaryLookupResponse.Result:type_name -> proto.DictionaryResponse
+	17, // 5: proto.DictionaryLookupBatchResponse.results:type_name -> proto.DictionaryLookupBatchResponse.ResultsEntry
+	8,  // 6: proto.DictionaryLookupStreamResponse.results:type_name -> proto.DictionaryResponse
+	13, // 7: proto.Example.source:type_name -> proto.ExampleSentence
+	13, // 8: proto.Example.target:type_name -> proto.ExampleSentence
+	14, // 9: proto.DictionaryExamplesResponse.results:type_name -> proto.Example
+	9,  // 10: proto.DictionaryLookupBatchResponse.ResultsEntry.value:type_name -> proto.DictionaryLookupResponses
+	1,  // 11: proto.TranslatorUser.DictionaryLookup:input_type -> proto.DictionaryLookupParameter
+	2,  // 12: proto.TranslatorUser.DictionaryLookupWithPos:input_type -> proto.DictionaryLookupWithPosParameter
+	3,  // 13: proto.TranslatorUser.DictionaryLookupBatch:input_type -> proto.DictionaryLookupBatchParameter
+	4,  // 14: proto.TranslatorUser.DictionaryLookupStream:input_type -> proto.DictionaryLookupStreamParameter
+	5,  // 15: proto.TranslatorUser.DictionaryReverseLookup:input_type -> proto.DictionaryReverseLookupParameter
+	6,  // 16: proto.TranslatorUser.DictionaryExamples:input_type -> proto.DictionaryExamplesParameter
+	7,  // 17: proto.TranslatorUser.Translate:input_type -> proto.TranslateParameter
+	9,  // 18: proto.TranslatorUser.DictionaryLookup:output_type -> proto.DictionaryLookupResponses
+	10, // 19: proto.TranslatorUser.DictionaryLookupWithPos:output_type -> proto.DictionaryLookupResponse
+	11, // 20: proto.TranslatorUser.DictionaryLookupBatch:output_type -> proto.DictionaryLookupBatchResponse
+	12, // 21: proto.TranslatorUser.DictionaryLookupStream:output_type -> proto.DictionaryLookupStreamResponse
+	9,  // 22: proto.TranslatorUser.DictionaryReverseLookup:output_type -> proto.DictionaryLookupResponses
+	15, // 23: proto.TranslatorUser.DictionaryExamples:output_type -> proto.DictionaryExamplesResponse
+	16, // 24: proto.TranslatorUser.Translate:output_type -> proto.TranslateResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_proto_translator_user_proto_init() }
+func file_proto_translator_user_proto_init() {
+	if File_proto_translator_user_proto != nil {
+		return
+	}
+	file_proto_translator_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_translator_user_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupWithPosParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupBatchParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupStreamParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_proto_translator_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryReverseLookupParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryExamplesParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupResponses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DictionaryLookupResponse); i {
 			case 0:
 				return &v.state
@@ -489,19 +1603,92 @@ func file_proto_translator_user_proto_init() {
 				return nil
 			}
 		}
+		file_proto_translator_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryLookupStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExampleSentence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Example); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryExamplesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_translator_user_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_translator_user_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_translator_user_proto_goTypes,
 		DependencyIndexes: file_proto_translator_user_proto_depIdxs,
+		EnumInfos:         file_proto_translator_user_proto_enumTypes,
 		MessageInfos:      file_proto_translator_user_proto_msgTypes,
 	}.Build()
 	File_proto_translator_user_proto = out.File
@@ -24,6 +24,11 @@ const _ = grpc.SupportPackageIsVersion7
 type TranslatorUserClient interface {
 	DictionaryLookup(ctx context.Context, in *DictionaryLookupParameter, opts ...grpc.CallOption) (*DictionaryLookupResponses, error)
 	DictionaryLookupWithPos(ctx context.Context, in *DictionaryLookupWithPosParameter, opts ...grpc.CallOption) (*DictionaryLookupResponse, error)
+	DictionaryLookupBatch(ctx context.Context, in *DictionaryLookupBatchParameter, opts ...grpc.CallOption) (*DictionaryLookupBatchResponse, error)
+	DictionaryLookupStream(ctx context.Context, opts ...grpc.CallOption) (TranslatorUser_DictionaryLookupStreamClient, error)
+	DictionaryReverseLookup(ctx context.Context, in *DictionaryReverseLookupParameter, opts ...grpc.CallOption) (*DictionaryLookupResponses, error)
+	DictionaryExamples(ctx context.Context, in *DictionaryExamplesParameter, opts ...grpc.CallOption) (*DictionaryExamplesResponse, error)
+	Translate(ctx context.Context, in *TranslateParameter, opts ...grpc.CallOption) (*TranslateResponse, error)
 }
 
 type translatorUserClient struct {
@@ -52,12 +57,84 @@ func (c *translatorUserClient) DictionaryLookupWithPos(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *translatorUserClient) DictionaryLookupBatch(ctx context.Context, in *DictionaryLookupBatchParameter, opts ...grpc.CallOption) (*DictionaryLookupBatchResponse, error) {
+	out := new(DictionaryLookupBatchResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorUser/DictionaryLookupBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorUserClient) DictionaryLookupStream(ctx context.Context, opts ...grpc.CallOption) (TranslatorUser_DictionaryLookupStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TranslatorUser_ServiceDesc.Streams[0], "/proto.TranslatorUser/DictionaryLookupStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &translatorUserDictionaryLookupStreamClient{stream}
+	return x, nil
+}
+
+type TranslatorUser_DictionaryLookupStreamClient interface {
+	Send(*DictionaryLookupStreamParameter) error
+	Recv() (*DictionaryLookupStreamResponse, error)
+	grpc.ClientStream
+}
+
+type translatorUserDictionaryLookupStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *translatorUserDictionaryLookupStreamClient) Send(m *DictionaryLookupStreamParameter) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *translatorUserDictionaryLookupStreamClient) Recv() (*DictionaryLookupStreamResponse, error) {
+	m := new(DictionaryLookupStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *translatorUserClient) DictionaryReverseLookup(ctx context.Context, in *DictionaryReverseLookupParameter, opts ...grpc.CallOption) (*DictionaryLookupResponses, error) {
+	out := new(DictionaryLookupResponses)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorUser/DictionaryReverseLookup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorUserClient) DictionaryExamples(ctx context.Context, in *DictionaryExamplesParameter, opts ...grpc.CallOption) (*DictionaryExamplesResponse, error) {
+	out := new(DictionaryExamplesResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorUser/DictionaryExamples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translatorUserClient) Translate(ctx context.Context, in *TranslateParameter, opts ...grpc.CallOption) (*TranslateResponse, error) {
+	out := new(TranslateResponse)
+	err := c.cc.Invoke(ctx, "/proto.TranslatorUser/Translate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TranslatorUserServer is the server API for TranslatorUser service.
 // All implementations must embed UnimplementedTranslatorUserServer
 // for forward compatibility
 type TranslatorUserServer interface {
 	DictionaryLookup(context.Context, *DictionaryLookupParameter) (*DictionaryLookupResponses, error)
 	DictionaryLookupWithPos(context.Context, *DictionaryLookupWithPosParameter) (*DictionaryLookupResponse, error)
+	DictionaryLookupBatch(context.Context, *DictionaryLookupBatchParameter) (*DictionaryLookupBatchResponse, error)
+	DictionaryLookupStream(TranslatorUser_DictionaryLookupStreamServer) error
+	DictionaryReverseLookup(context.Context, *DictionaryReverseLookupParameter) (*DictionaryLookupResponses, error)
+	DictionaryExamples(context.Context, *DictionaryExamplesParameter) (*DictionaryExamplesResponse, error)
+	Translate(context.Context, *TranslateParameter) (*TranslateResponse, error)
 	mustEmbedUnimplementedTranslatorUserServer()
 }
 
@@ -71,6 +148,21 @@ func (UnimplementedTranslatorUserServer) DictionaryLookup(context.Context, *Dict
 func (UnimplementedTranslatorUserServer) DictionaryLookupWithPos(context.Context, *DictionaryLookupWithPosParameter) (*DictionaryLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DictionaryLookupWithPos not implemented")
 }
+func (UnimplementedTranslatorUserServer) DictionaryLookupBatch(context.Context, *DictionaryLookupBatchParameter) (*DictionaryLookupBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DictionaryLookupBatch not implemented")
+}
+func (UnimplementedTranslatorUserServer) DictionaryLookupStream(TranslatorUser_DictionaryLookupStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DictionaryLookupStream not implemented")
+}
+func (UnimplementedTranslatorUserServer) DictionaryReverseLookup(context.Context, *DictionaryReverseLookupParameter) (*DictionaryLookupResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DictionaryReverseLookup not implemented")
+}
+func (UnimplementedTranslatorUserServer) DictionaryExamples(context.Context, *DictionaryExamplesParameter) (*DictionaryExamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DictionaryExamples not implemented")
+}
+func (UnimplementedTranslatorUserServer) Translate(context.Context, *TranslateParameter) (*TranslateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Translate not implemented")
+}
 func (UnimplementedTranslatorUserServer) mustEmbedUnimplementedTranslatorUserServer() {}
 
 // UnsafeTranslatorUserServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +212,104 @@ func _TranslatorUser_DictionaryLookupWithPos_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TranslatorUser_DictionaryLookupBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryLookupBatchParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorUserServer).DictionaryLookupBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorUser/DictionaryLookupBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorUserServer).DictionaryLookupBatch(ctx, req.(*DictionaryLookupBatchParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorUser_DictionaryLookupStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TranslatorUserServer).DictionaryLookupStream(&translatorUserDictionaryLookupStreamServer{stream})
+}
+
+type TranslatorUser_DictionaryLookupStreamServer interface {
+	Send(*DictionaryLookupStreamResponse) error
+	Recv() (*DictionaryLookupStreamParameter, error)
+	grpc.ServerStream
+}
+
+type translatorUserDictionaryLookupStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *translatorUserDictionaryLookupStreamServer) Send(m *DictionaryLookupStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *translatorUserDictionaryLookupStreamServer) Recv() (*DictionaryLookupStreamParameter, error) {
+	m := new(DictionaryLookupStreamParameter)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TranslatorUser_DictionaryReverseLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryReverseLookupParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorUserServer).DictionaryReverseLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorUser/DictionaryReverseLookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorUserServer).DictionaryReverseLookup(ctx, req.(*DictionaryReverseLookupParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorUser_DictionaryExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DictionaryExamplesParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorUserServer).DictionaryExamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorUser/DictionaryExamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorUserServer).DictionaryExamples(ctx, req.(*DictionaryExamplesParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TranslatorUser_Translate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslatorUserServer).Translate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TranslatorUser/Translate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslatorUserServer).Translate(ctx, req.(*TranslateParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TranslatorUser_ServiceDesc is the grpc.ServiceDesc for TranslatorUser service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,7 +325,30 @@ var TranslatorUser_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DictionaryLookupWithPos",
 			Handler:    _TranslatorUser_DictionaryLookupWithPos_Handler,
 		},
+		{
+			MethodName: "DictionaryLookupBatch",
+			Handler:    _TranslatorUser_DictionaryLookupBatch_Handler,
+		},
+		{
+			MethodName: "DictionaryReverseLookup",
+			Handler:    _TranslatorUser_DictionaryReverseLookup_Handler,
+		},
+		{
+			MethodName: "DictionaryExamples",
+			Handler:    _TranslatorUser_DictionaryExamples_Handler,
+		},
+		{
+			MethodName: "Translate",
+			Handler:    _TranslatorUser_Translate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DictionaryLookupStream",
+			Handler:       _TranslatorUser_DictionaryLookupStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/translator_user.proto",
 }